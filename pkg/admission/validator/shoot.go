@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -72,62 +73,148 @@ func (s *shoot) Validate(ctx context.Context, newObj, _ client.Object) error {
 		return err
 	}
 
-	if rsyslogRelpConfig.TLS != nil && rsyslogRelpConfig.TLS.Enabled {
-		secretName, err := getReferencedResourceName(shoot, "Secret", *rsyslogRelpConfig.TLS.SecretReferenceName)
-		if err != nil {
-			return err
-		}
+	if err := validateWorkerPoolNames(shoot, rsyslogRelpConfig.WorkerPools, providerConfigPath.Child("workerPools")).ToAggregate(); err != nil {
+		return err
+	}
 
-		// validate the secret
-		secret := &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      secretName,
-				Namespace: shoot.Namespace,
-			},
-		}
+	if err := s.validateTLSSecret(ctx, shoot, rsyslogRelpConfig.TLS); err != nil {
+		return err
+	}
 
-		secretKey := client.ObjectKeyFromObject(secret)
-		if err := s.apiReader.Get(ctx, secretKey, secret); err != nil {
-			if errors.IsNotFound(err) {
-				return fmt.Errorf("referenced secret %s does not exist", secretKey.String())
-			}
+	if err := s.validateHTTPTokenSecret(ctx, shoot, rsyslogRelpConfig.HTTP); err != nil {
+		return err
+	}
 
-			return fmt.Errorf("failed to get referenced secret %s with error: %w", secretKey.String(), err)
+	if rsyslogRelpConfig.FailoverTarget != nil {
+		if err := s.validateTLSSecret(ctx, shoot, rsyslogRelpConfig.FailoverTarget.TLS); err != nil {
+			return err
+		}
+		if err := s.validateHTTPTokenSecret(ctx, shoot, rsyslogRelpConfig.FailoverTarget.HTTP); err != nil {
+			return err
 		}
+	}
 
-		if err := validateRsyslogRelpSecret(secret); err != nil {
+	for _, additionalTarget := range rsyslogRelpConfig.AdditionalTargets {
+		if err := s.validateTLSSecret(ctx, shoot, additionalTarget.TLS); err != nil {
 			return err
 		}
+		if err := s.validateHTTPTokenSecret(ctx, shoot, additionalTarget.HTTP); err != nil {
+			return err
+		}
+	}
+
+	if err := s.validateAuditConfig(ctx, shoot, rsyslogRelpConfig.AuditConfig); err != nil {
+		return err
 	}
 
-	if rsyslogRelpConfig.AuditConfig != nil && rsyslogRelpConfig.AuditConfig.Enabled && rsyslogRelpConfig.AuditConfig.ConfigMapReferenceName != nil {
-		configMapName, err := getReferencedResourceName(shoot, "ConfigMap", *rsyslogRelpConfig.AuditConfig.ConfigMapReferenceName)
-		if err != nil {
+	for _, workerPool := range rsyslogRelpConfig.WorkerPools {
+		if err := s.validateAuditConfig(ctx, shoot, workerPool.AuditConfig); err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
+
+// validateWorkerPoolNames validates that the overrides of the worker pools refer to worker pools of the shoot.
+func validateWorkerPoolNames(shoot *core.Shoot, workerPools []rsyslog.WorkerPool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
 
-		configMap := &corev1.ConfigMap{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      configMapName,
-				Namespace: shoot.Namespace,
-			},
+	workerNames := sets.New[string]()
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerNames.Insert(worker.Name)
+	}
+
+	for index, workerPool := range workerPools {
+		if !workerNames.Has(workerPool.Name) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Index(index).Child("name"), workerPool.Name))
 		}
+	}
 
-		configMapKey := client.ObjectKeyFromObject(configMap)
-		if err := s.apiReader.Get(ctx, configMapKey, configMap); err != nil {
-			if errors.IsNotFound(err) {
-				return fmt.Errorf("referenced configMap %s does not exist", configMapKey.String())
-			}
+	return allErrs
+}
 
-			return fmt.Errorf("failed to get referenced configMap %s with error: %w", configMapKey.String(), err)
+// validateAuditConfig validates the configmap referenced by the passed audit configuration if auditing is enabled.
+func (s *shoot) validateAuditConfig(ctx context.Context, shoot *core.Shoot, auditConfig *rsyslog.AuditConfig) error {
+	if auditConfig == nil || !auditConfig.Enabled || auditConfig.ConfigMapReferenceName == nil {
+		return nil
+	}
+
+	configMapName, err := getReferencedResourceName(shoot, "ConfigMap", *auditConfig.ConfigMapReferenceName)
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName,
+			Namespace: shoot.Namespace,
+		},
+	}
+
+	configMapKey := client.ObjectKeyFromObject(configMap)
+	if err := s.apiReader.Get(ctx, configMapKey, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("referenced configMap %s does not exist", configMapKey.String())
 		}
 
-		if err := validateAuditConfigMap(s.decoder, configMap); err != nil {
-			return err
+		return fmt.Errorf("failed to get referenced configMap %s with error: %w", configMapKey.String(), err)
+	}
+
+	return validateAuditConfigMap(s.decoder, configMap)
+}
+
+// validateTLSSecret validates the secret referenced by the passed TLS configuration if TLS is enabled.
+func (s *shoot) validateTLSSecret(ctx context.Context, shoot *core.Shoot, tls *rsyslog.TLS) error {
+	if tls == nil || !tls.Enabled {
+		return nil
+	}
+
+	secret, err := s.getReferencedSecret(ctx, shoot, *tls.SecretReferenceName)
+	if err != nil {
+		return err
+	}
+
+	return validateRsyslogRelpSecret(secret)
+}
+
+// validateHTTPTokenSecret validates the secret referenced by the passed http configuration if a token is configured.
+func (s *shoot) validateHTTPTokenSecret(ctx context.Context, shoot *core.Shoot, http *rsyslog.HTTP) error {
+	if http == nil || http.TokenSecretReferenceName == nil {
+		return nil
+	}
+
+	secret, err := s.getReferencedSecret(ctx, shoot, *http.TokenSecretReferenceName)
+	if err != nil {
+		return err
+	}
+
+	return validateHTTPTokenSecret(secret)
+}
+
+func (s *shoot) getReferencedSecret(ctx context.Context, shoot *core.Shoot, secretReferenceName string) (*corev1.Secret, error) {
+	secretName, err := getReferencedResourceName(shoot, "Secret", secretReferenceName)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: shoot.Namespace,
+		},
+	}
+
+	secretKey := client.ObjectKeyFromObject(secret)
+	if err := s.apiReader.Get(ctx, secretKey, secret); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("referenced secret %s does not exist", secretKey.String())
 		}
+
+		return nil, fmt.Errorf("failed to get referenced secret %s with error: %w", secretKey.String(), err)
 	}
 
-	return nil
+	return secret, nil
 }
 
 // validateRsyslogRelpSecret validates the content of an rsyslog relp secret.
@@ -152,6 +239,22 @@ func validateRsyslogRelpSecret(secret *corev1.Secret) error {
 	return nil
 }
 
+// validateHTTPTokenSecret validates the content of a secret containing the token for an http ingestion endpoint.
+func validateHTTPTokenSecret(secret *corev1.Secret) error {
+	key := client.ObjectKeyFromObject(secret)
+	if len(secret.Data[constants.RsyslogHTTPTokenKey]) == 0 {
+		return fmt.Errorf("secret %s is missing %s value", key.String(), constants.RsyslogHTTPTokenKey)
+	}
+	if !ptr.Deref(secret.Immutable, false) {
+		return fmt.Errorf("secret %s must be immutable", key.String())
+	}
+	if len(secret.Data) != 1 {
+		return fmt.Errorf("secret %s should have only one data entry", key.String())
+	}
+
+	return nil
+}
+
 // validateAuditConfigMap validates the content of a configmap containing audit config.
 func validateAuditConfigMap(decoder runtime.Decoder, configMap *corev1.ConfigMap) error {
 	configMapKey := client.ObjectKeyFromObject(configMap)
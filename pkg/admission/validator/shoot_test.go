@@ -179,6 +179,24 @@ reportSuspensionContinuation: true`)...)
 				Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
 			})
 
+			It("should not return error when severities are specified by name", func() {
+				shoot.Spec.Extensions[0].ProviderConfig.Raw = append(shoot.Spec.Extensions[0].ProviderConfig.Raw, []byte(`
+- severityRange:
+    min: err
+    max: warning
+  programNames: ["containerd"]`)...)
+
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+			})
+
+			It("should return error when an unknown severity name is specified", func() {
+				shoot.Spec.Extensions[0].ProviderConfig.Raw = append(shoot.Spec.Extensions[0].ProviderConfig.Raw, []byte(`
+- severity: warn
+  programNames: ["containerd"]`)...)
+
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring(`unknown severity "warn"`)))
+			})
+
 			Context("when TLS is enabled", func() {
 				BeforeEach(func() {
 					shoot.Spec.Extensions[0].ProviderConfig.Raw = append(shoot.Spec.Extensions[0].ProviderConfig.Raw, []byte(`
@@ -337,6 +355,195 @@ tls:
 				})
 			})
 
+			Context("when additional targets are configured", func() {
+				BeforeEach(func() {
+					shoot.Spec.Extensions[0].ProviderConfig.Raw = append(shoot.Spec.Extensions[0].ProviderConfig.Raw, []byte(`
+additionalTargets:
+- name: security
+  target: security.example.com
+  port: 10251
+  loggingRules:
+  - programNames: ["audisp-syslog"]
+  tls:
+    enabled: true
+    secretReferenceName: rsyslog-secret-security`)...)
+					shoot.Spec.Resources = []core.NamedResourceReference{
+						{
+							Name: "rsyslog-secret-security",
+							ResourceRef: autoscalingv1.CrossVersionObjectReference{
+								Kind:       "Secret",
+								Name:       "rsyslog-secret-security",
+								APIVersion: "v1",
+							},
+						},
+					}
+				})
+
+				It("should return error if the secret referenced by an additional target does not exist", func() {
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring("referenced secret bar/rsyslog-secret-security does not exist")))
+				})
+
+				It("should return error if the secret referenced by an additional target is not valid", func() {
+					secret := &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "rsyslog-secret-security",
+							Namespace: "bar",
+						},
+						Immutable: ptr.To(true),
+						Data: map[string][]byte{
+							"ca":  []byte("data"),
+							"crt": []byte("data"),
+						},
+					}
+
+					Expect(fakeGardenClient.Create(ctx, secret)).To(Succeed())
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring("secret bar/rsyslog-secret-security is missing key value")))
+				})
+
+				It("should not return error if the secret referenced by an additional target is valid", func() {
+					secret := &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "rsyslog-secret-security",
+							Namespace: "bar",
+						},
+						Immutable: ptr.To(true),
+						Data: map[string][]byte{
+							"ca":  []byte("data"),
+							"crt": []byte("data"),
+							"key": []byte("data"),
+						},
+					}
+
+					Expect(fakeGardenClient.Create(ctx, secret)).To(Succeed())
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(Succeed())
+				})
+			})
+
+			Context("when a target uses http", func() {
+				BeforeEach(func() {
+					shoot.Spec.Extensions[0].ProviderConfig.Raw = append(shoot.Spec.Extensions[0].ProviderConfig.Raw, []byte(`
+outputFormat: json
+additionalTargets:
+- name: splunk
+  target: splunk.example.com
+  port: 8088
+  protocol: http
+  http:
+    path: services/collector/raw
+    tokenSecretReferenceName: splunk-token
+  loggingRules:
+  - programNames: ["audisp-syslog"]`)...)
+					shoot.Spec.Resources = []core.NamedResourceReference{
+						{
+							Name: "splunk-token",
+							ResourceRef: autoscalingv1.CrossVersionObjectReference{
+								Kind:       "Secret",
+								Name:       "splunk-token",
+								APIVersion: "v1",
+							},
+						},
+					}
+				})
+
+				It("should return error if the referenced token secret does not exist", func() {
+					Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring("referenced secret bar/splunk-token does not exist")))
+				})
+
+				DescribeTable("validating the referenced token secret",
+					func(data map[string][]byte, immutable bool, matcher types.GomegaMatcher) {
+						secret := &corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "splunk-token",
+								Namespace: "bar",
+							},
+							Immutable: &immutable,
+							Data:      data,
+						}
+
+						Expect(fakeGardenClient.Create(ctx, secret)).To(Succeed())
+						Expect(shootValidator.Validate(ctx, shoot, nil)).To(matcher)
+					},
+					Entry("should not return error if secret is valid",
+						map[string][]byte{"token": []byte("Splunk token")}, true,
+						Succeed(),
+					),
+					Entry("should return error if secret does not contain 'token' data entry",
+						map[string][]byte{"ca": []byte("data")}, true,
+						MatchError(ContainSubstring("secret bar/splunk-token is missing token value")),
+					),
+					Entry("should return error if secret contains additional data entries",
+						map[string][]byte{"token": []byte("Splunk token"), "extra": []byte("data")}, true,
+						MatchError(ContainSubstring("secret bar/splunk-token should have only one data entry")),
+					),
+					Entry("should return error if secret is mutable",
+						map[string][]byte{"token": []byte("Splunk token")}, false,
+						MatchError(ContainSubstring("secret bar/splunk-token must be immutable")),
+					),
+				)
+			})
+
+			It("should return error if the secret referenced by the failover target does not exist", func() {
+				shoot.Spec.Extensions[0].ProviderConfig.Raw = append(shoot.Spec.Extensions[0].ProviderConfig.Raw, []byte(`
+failoverTarget:
+  target: standby.example.com
+  port: 10251
+  tls:
+    enabled: true
+    secretReferenceName: rsyslog-secret-standby`)...)
+				shoot.Spec.Resources = []core.NamedResourceReference{
+					{
+						Name: "rsyslog-secret-standby",
+						ResourceRef: autoscalingv1.CrossVersionObjectReference{
+							Kind:       "Secret",
+							Name:       "rsyslog-secret-standby",
+							APIVersion: "v1",
+						},
+					},
+				}
+
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring("referenced secret bar/rsyslog-secret-standby does not exist")))
+			})
+
+			It("should return error if the configMap referenced by a worker pool does not exist", func() {
+				shoot.Spec.Extensions[0].ProviderConfig.Raw = append(shoot.Spec.Extensions[0].ProviderConfig.Raw, []byte(`
+workerPools:
+- name: pci
+  auditConfig:
+    enabled: true
+    configMapReferenceName: pci-audit-configmap`)...)
+				shoot.Spec.Provider.Workers = []core.Worker{{Name: "pci"}}
+				shoot.Spec.Resources = []core.NamedResourceReference{
+					{
+						Name: "pci-audit-configmap",
+						ResourceRef: autoscalingv1.CrossVersionObjectReference{
+							Kind:       "ConfigMap",
+							Name:       "pci-audit-configmap",
+							APIVersion: "v1",
+						},
+					},
+				}
+
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(MatchError(ContainSubstring("referenced configMap bar/pci-audit-configmap does not exist")))
+			})
+
+			It("should return error if a worker pool is not a worker pool of the shoot", func() {
+				shoot.Spec.Extensions[0].ProviderConfig.Raw = append(shoot.Spec.Extensions[0].ProviderConfig.Raw, []byte(`
+workerPools:
+- name: pci
+  target: pci.example.com
+- name: dev
+  port: 10251`)...)
+				shoot.Spec.Provider.Workers = []core.Worker{{Name: "pci"}, {Name: "prod"}}
+
+				Expect(shootValidator.Validate(ctx, shoot, nil)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeNotFound),
+						"Field":    Equal("spec.extensions[0].providerConfig.workerPools[1].name"),
+						"BadValue": Equal("dev"),
+					})),
+				))
+			})
+
 			Context("when AuditConfig.ConfigMapReferenceName is not nil", func() {
 				BeforeEach(func() {
 					shoot.Spec.Extensions[0].ProviderConfig.Raw = append(shoot.Spec.Extensions[0].ProviderConfig.Raw, []byte(`
@@ -18,14 +18,23 @@ type RsyslogRelpConfig struct {
 	Target string
 	// Port is the TCP port to use when connecting to the target server.
 	Port int
+	// Protocol is the protocol used for sending log messages to the target server.
+	Protocol *Protocol
+	// HTTP contains options for sending log messages to an http ingestion endpoint of the target server.
+	// Can only be set if Protocol is "http" or "otlp".
+	HTTP *HTTP
 	// TLS hods the TLS config.
 	TLS *TLS
 	// LoggingRules contain a list of LoggingRules that are used to determine which logs are
 	// sent to the target server by the the rsyslog relp action.
 	LoggingRules []LoggingRule
+	// ExcludeRules contain a list of LoggingRules that are used to determine which logs are discarded before
+	// they are sent to any of the target servers.
+	ExcludeRules []LoggingRule
 	// RebindInterval is the rebind interval for the rsyslog relp action.
 	RebindInterval *int
-	// Timeout is the connection timeout for the rsyslog relp action.
+	// Timeout is the connection timeout for the rsyslog relp action. If the target server is reached via http or otlp,
+	// it is the timeout of the requests.
 	Timeout *int
 	// ResumeRetryCount is the resume retry count for the rsyslog relp action.
 	ResumeRetryCount *int
@@ -35,6 +44,260 @@ type RsyslogRelpConfig struct {
 	// AuditConfig contains configuration that can be used to setup node level auditing so that audit logs
 	// can be forwarded via rsyslog to the target RELP server.
 	AuditConfig *AuditConfig
+	// AdditionalTargets contain a list of further target servers to which logs are forwarded via relp
+	// in addition to the target server configured above.
+	AdditionalTargets []RelpTarget
+	// FailoverTarget is a standby target server to which logs are forwarded only while the rsyslog relp action
+	// of the primary target server is suspended.
+	FailoverTarget *FailoverTarget
+	// Queue contains options for the disk-assisted queues of the rsyslog relp actions.
+	Queue *Queue
+	// OutputFormat is the format of the log messages sent to the target servers.
+	OutputFormat *OutputFormat
+	// StaticMetadata contains additional key/value pairs which are added to every log message sent to the
+	// target servers next to the project name, shoot name and shoot UID.
+	StaticMetadata map[string]string
+	// NodeMetadata contains options for adding metadata about the node and its worker pool to every log message
+	// sent to the target servers.
+	NodeMetadata *NodeMetadata
+	// WorkerPools contain overrides of the configuration above for the nodes of individual worker pools.
+	WorkerPools []WorkerPool
+	// Input contains options for the input from which rsyslog reads log messages on the nodes.
+	Input *Input
+	// ContainerLogs contains options for forwarding the logs of the containers running on the nodes.
+	ContainerLogs *ContainerLogs
+	// KernelLogs contains options for forwarding the messages of the kernel ring buffer of the nodes.
+	KernelLogs *KernelLogs
+	// Vali contains options for pushing the log messages sent to the target server to the Vali instance of the shoot.
+	Vali *Vali
+	// LocalCopy contains options for writing the log messages sent to the target server to a file on the nodes.
+	LocalCopy *LocalCopy
+	// Redaction contains rules for masking sensitive data, e.g. secrets or personal data, in the log messages before
+	// they are sent to the target servers. The rules are applied in order.
+	Redaction []RedactionRule
+	// AuditStream contains options for sending the audit events of the nodes to the target server separately from
+	// the other log messages.
+	AuditStream *AuditStream
+}
+
+// AuditStream contains options for sending the audit events of the nodes to the target server via a dedicated
+// rsyslog relp action and queue, which blocks new audit events instead of discarding them when it is full.
+type AuditStream struct {
+	// Enabled determines whether the audit events are sent via a dedicated rsyslog relp action and queue.
+	Enabled bool
+	// Port overrides the TCP port to use when sending the audit events to the target server.
+	Port *int
+	// Queue contains options for the disk-assisted queue of the audit stream.
+	Queue *AuditStreamQueue
+}
+
+// AuditStreamQueue contains options for the disk-assisted queue of the audit stream.
+type AuditStreamQueue struct {
+	// Size is the maximum number of audit events held in memory by the queue.
+	Size *int
+	// MaxDiskSpace is the maximum disk space the queue may use for spooling audit events, e.g. "512m" or "1g".
+	MaxDiskSpace *string
+}
+
+// RedactionRule defines a pattern which is replaced in the log messages before they are sent to the target servers.
+type RedactionRule struct {
+	// Preset is the name of a built-in pattern.
+	Preset *RedactionPreset
+	// Pattern is a POSIX ERE regular expression restricted to the syntax which jq interprets in the same way. All of
+	// its matches in the log messages are replaced.
+	Pattern *string
+	// Replacement is the text the matches are replaced with.
+	Replacement *string
+}
+
+// LocalCopy contains options for writing the log messages sent to the target server to a file on the nodes.
+type LocalCopy struct {
+	// Enabled determines whether the log messages sent to the target server are also written to a file on the nodes.
+	Enabled bool
+	// MaxSize is the size of the file at which it is rotated, e.g. "100m" or "1g".
+	MaxSize *string
+	// MaxFiles is the number of rotated files which are kept on the nodes.
+	MaxFiles *int
+	// MaxAge is the age in minutes after which rotated files are deleted from the nodes.
+	MaxAge *int
+}
+
+// Vali contains options for pushing the log messages sent to the target server to the Vali instance of the shoot.
+type Vali struct {
+	// Enabled determines whether the log messages sent to the target server are also pushed to the Vali instance of
+	// the shoot in the seed, so that they can be viewed in the Plutono instance of the shoot.
+	Enabled bool
+}
+
+// KernelLogs contains options for forwarding the messages of the kernel ring buffer of the nodes.
+type KernelLogs struct {
+	// Enabled determines whether the messages of the kernel ring buffer are forwarded to the target server.
+	Enabled bool
+	// LoggingRules contain a list of LoggingRules that are used to determine which kernel messages are
+	// sent to the target server. If empty, all kernel messages are sent.
+	LoggingRules []LoggingRule
+}
+
+// ContainerLogs contains options for forwarding the logs of the containers running on the nodes.
+type ContainerLogs struct {
+	// Enabled determines whether the logs of the containers running on the nodes are forwarded to the target server.
+	Enabled bool
+	// Namespaces contain the names of the namespaces whose container logs are forwarded. If empty, the container
+	// logs of all namespaces are forwarded.
+	Namespaces []string
+	// PodSelector selects the pods whose container logs are forwarded by their labels. If not set, the container
+	// logs of all pods are forwarded.
+	PodSelector *metav1.LabelSelector
+}
+
+// Input contains options for the input from which rsyslog reads log messages on the nodes.
+type Input struct {
+	// Mode is the input module used by rsyslog to read log messages on the nodes.
+	// Possible values are "imuxsock" or "imjournal".
+	// If the field is omitted, log messages are read from the syslog socket of journald via imuxsock.
+	Mode *InputMode
+	// ReplayJournal determines whether the log messages already stored in the journal are forwarded when rsyslog
+	// reads from the journal for the first time on a node. Can only be set if Mode is "imjournal".
+	ReplayJournal *bool
+	// JournalFields contain names of journal fields, e.g. "_SYSTEMD_UNIT", which are added to every log message
+	// sent to the target servers. Can only be set if Mode is "imjournal".
+	JournalFields []string
+}
+
+// WorkerPool contains overrides of the rsyslog relp configuration for the nodes of a worker pool.
+// Fields which are omitted are taken from the rsyslog relp configuration. The connection settings of the target server,
+// e.g. its protocol and tls options, cannot be overridden.
+type WorkerPool struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Target overrides the target server to connect to via relp.
+	Target *string
+	// Port overrides the TCP port to use when connecting to the target server.
+	Port *int
+	// LoggingRules override the LoggingRules that are used to determine which logs are
+	// sent to the target server by the rsyslog relp action.
+	LoggingRules []LoggingRule
+	// AuditConfig overrides the configuration of node level auditing.
+	AuditConfig *AuditConfig
+	// Queue overrides the options for the disk-assisted queues of the rsyslog relp actions.
+	Queue *Queue
+}
+
+// NodeMetadata contains options for adding metadata about the node and its worker pool to the log messages.
+type NodeMetadata struct {
+	// Enabled determines whether the worker pool name, machine type, zones, region, seed name and kubernetes version
+	// of the node are added to the log messages.
+	Enabled bool
+}
+
+// Queue contains options for the disk-assisted queues which buffer logs while a target server is not reachable.
+// The options apply to the queue of each target server.
+type Queue struct {
+	// Size is the maximum number of messages held in memory by the queue.
+	Size *int
+	// MaxDiskSpace is the maximum disk space the queue may use for spooling messages, e.g. "48m" or "1g".
+	MaxDiskSpace *string
+	// HighWatermark is the number of messages in the queue at which it starts spooling messages to disk.
+	HighWatermark *int
+	// LowWatermark is the number of messages in the queue at which it stops spooling messages to disk.
+	LowWatermark *int
+	// DiscardMark is the number of messages in the queue at which messages with a severity of DiscardSeverity
+	// or less important are discarded.
+	DiscardMark *int
+	// DiscardSeverity is the severity from which messages are discarded when the DiscardMark is reached.
+	DiscardSeverity *int
+	// DequeueBatchSize is the maximum number of messages that are dequeued and sent to the target server at once.
+	DequeueBatchSize *int
+	// WorkerThreads is the maximum number of worker threads processing the queue.
+	WorkerThreads *int
+}
+
+// FailoverTarget contains options for a standby target server which takes over while the primary target server
+// is not reachable.
+type FailoverTarget struct {
+	// Target is the target server to connect to via relp.
+	Target string
+	// Port is the TCP port to use when connecting to the target server.
+	Port int
+	// Protocol is the protocol used for sending log messages to the target server.
+	Protocol *Protocol
+	// HTTP contains options for sending log messages to an http ingestion endpoint of the target server.
+	// Can only be set if Protocol is "http" or "otlp".
+	HTTP *HTTP
+	// TLS hods the TLS config.
+	TLS *TLS
+	// RebindInterval is the rebind interval for the rsyslog relp action.
+	RebindInterval *int
+	// Timeout is the connection timeout for the rsyslog relp action. If the target server is reached via http or otlp,
+	// it is the timeout of the requests.
+	Timeout *int
+	// ResumeRetryCount is the resume retry count for the rsyslog relp action.
+	ResumeRetryCount *int
+	// ReportSuspensionContinuation determines whether suspension continuation in the relp action
+	// should be reported.
+	ReportSuspensionContinuation *bool
+}
+
+// RelpTarget contains options for an additional target server to which logs are forwarded via relp.
+// Each RelpTarget is served by its own rsyslog relp action with a dedicated queue.
+type RelpTarget struct {
+	// Name is the unique name of the target. It is used to name the rsyslog relp action and queue of the target.
+	Name string
+	// Target is the target server to connect to via relp.
+	Target string
+	// Port is the TCP port to use when connecting to the target server.
+	Port int
+	// Protocol is the protocol used for sending log messages to the target server.
+	Protocol *Protocol
+	// HTTP contains options for sending log messages to an http ingestion endpoint of the target server.
+	// Can only be set if Protocol is "http" or "otlp".
+	HTTP *HTTP
+	// TLS hods the TLS config.
+	TLS *TLS
+	// LoggingRules contain a list of LoggingRules that are used to determine which logs are
+	// sent to the target server by the rsyslog relp action of the target.
+	LoggingRules []LoggingRule
+	// RebindInterval is the rebind interval for the rsyslog relp action.
+	RebindInterval *int
+	// Timeout is the connection timeout for the rsyslog relp action. If the target server is reached via http or otlp,
+	// it is the timeout of the requests.
+	Timeout *int
+	// ResumeRetryCount is the resume retry count for the rsyslog relp action.
+	ResumeRetryCount *int
+	// ReportSuspensionContinuation determines whether suspension continuation in the relp action
+	// should be reported.
+	ReportSuspensionContinuation *bool
+}
+
+// HTTP contains options for sending log messages to an http ingestion endpoint of the target server.
+type HTTP struct {
+	// Path is the path of the ingestion endpoint on the target server, e.g. "services/collector/raw".
+	// If the field is omitted and Protocol is "otlp", the default path of OTLP/HTTP log exports "v1/logs" is used.
+	Path *string
+	// TokenSecretReferenceName is the name of the reference for the secret containing the value of the
+	// Authorization header sent to the target server, e.g. "Splunk <token>" or "Bearer <token>".
+	TokenSecretReferenceName *string
+	// Batch contains options for sending multiple log messages to the target server in a single request.
+	// If the field is omitted, every log message is sent in its own request. Can only be set if Protocol is "http".
+	Batch *HTTPBatch
+	// Retry determines whether log messages rejected by the target server with a client error (4xx) are sent again.
+	// The omhttp module only retries requests which failed with a server error (5xx) or did not reach the target
+	// server, and drops log messages rejected with a client error, e.g. 429 Too Many Requests. If enabled, the
+	// rejected log messages are sent again until the target server accepts them.
+	// If the field is omitted, log messages rejected with a client error are dropped.
+	Retry *bool
+}
+
+// HTTPBatch contains options for sending multiple log messages to the target server in a single request.
+type HTTPBatch struct {
+	// Format is the format in which the log messages of a batch are combined.
+	// Possible values are "newline" or "jsonarray".
+	// If the field is omitted, the log messages are separated by newlines.
+	Format *HTTPBatchFormat
+	// MaxSize is the maximum number of log messages in a batch.
+	MaxSize *int
+	// MaxBytes is the maximum size of a batch in bytes.
+	MaxBytes *int
 }
 
 // TLS contains options for the tls connection to the target server.
@@ -60,11 +323,106 @@ type TLS struct {
 // LoggingRule contains options that determines which logs are sent to the target server.
 type LoggingRule struct {
 	// ProgramNames are the names of the programs for which logs are sent to the target server.
+	// The names can contain the wildcards `*`, matching any number of characters, and `?`, matching a single character.
 	ProgramNames []string
-	// Severity determines which logs are sent to the target server based on their severity.
+	// SystemdUnits are the names of the systemd units for which logs are sent to the target server.
+	SystemdUnits []string
+	// Facilities are the names of the syslog facilities, e.g. "kern" or "authpriv", for which logs are sent
+	// to the target server.
+	Facilities []string
+	// Severity determines which logs are sent to the target server based on their severity. Logs with the given
+	// severity or a more severe one, i.e. one with a lower numerical code, are sent.
 	Severity *int
+	// SeverityRange determines which logs are sent to the target server based on a range of severities.
+	SeverityRange *SeverityRange
 	// MessageContent defines regular expressions for including and excluding logs based on their message content.
 	MessageContent *MessageContent
+	// PropertyMatchers match properties of the logs, e.g. the hostname or a journal field. Logs are only sent to the
+	// target server if all matchers match.
+	PropertyMatchers []PropertyMatcher
+	// Condition is a boolean expression over the properties of the logs, which has to be true for logs to be sent to
+	// the target server.
+	Condition *Condition
+	// RateLimit limits the number of logs matching the rule which are sent to the target server.
+	RateLimit *RateLimit
+	// Sampling determines that only a sample of the logs matching the rule is sent to the target server.
+	Sampling *Sampling
+	// Transformations change properties of the logs matching the rule before they are sent to the target server.
+	Transformations []Transformation
+}
+
+// Transformation changes a property of the logs matching a logging rule before they are sent to the target server.
+type Transformation struct {
+	// Severity overrides the severity of the logs.
+	Severity *int
+	// SyslogTag overrides the syslog tag of the logs.
+	SyslogTag *string
+	// AppName overrides the app-name of the logs.
+	AppName *string
+	// Field adds a custom field to the logs.
+	Field *CustomField
+}
+
+// CustomField is a field which is added to the logs sent to the target server.
+type CustomField struct {
+	// Name is the name of the field.
+	Name string
+	// Value is the value of the field.
+	Value string
+}
+
+// RateLimit limits the number of logs matching a logging rule which are sent to the target server.
+type RateLimit struct {
+	// Interval is the length of the interval in seconds for which the Burst applies.
+	Interval int
+	// Burst is the maximum number of logs matching the rule which are sent to the target server per interval.
+	Burst int
+}
+
+// Sampling determines that only a sample of the logs matching a logging rule is sent to the target server.
+type Sampling struct {
+	// OneIn is the number N when keeping one in N of the logs matching the rule.
+	OneIn int
+}
+
+// Condition is a boolean expression over the properties of the logs. Exactly one of its fields has to be set.
+type Condition struct {
+	// AllOf is true if all of the conditions are true.
+	AllOf []Condition
+	// AnyOf is true if at least one of the conditions is true.
+	AnyOf []Condition
+	// Not is true if the condition is false.
+	Not *Condition
+	// Match is true if the property matcher matches.
+	Match *PropertyMatcher
+	// Severity is true if the syslog severity of the logs is lower than or equal to the given value.
+	Severity *int
+}
+
+// SeverityRange is a range of syslog severities. If Min and Max are equal, only logs with exactly this severity are
+// matched.
+type SeverityRange struct {
+	// Min is the lowest numerical code in the range, i.e. the most severe severity.
+	Min *int
+	// Max is the highest numerical code in the range, i.e. the least severe severity.
+	Max *int
+}
+
+// SeverityNames are the names of the syslog severities, indexed by their numerical code. They match the names returned
+// by the syslogseverity-text property of rsyslog.
+var SeverityNames = []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}
+
+// PropertyMatcher matches a property of the logs against a value.
+type PropertyMatcher struct {
+	// Property is the name of the matched property. Exactly one of Property or JournalField has to be set.
+	Property *MessageProperty
+	// JournalField is the name of the matched journal field, e.g. "_SYSTEMD_UNIT".
+	JournalField *string
+	// Operator is the operator used for matching the property against the value.
+	// Defaults to "equals".
+	Operator *PropertyMatchOperator
+	// Value is the value, prefix or POSIX ERE regular expression the property is matched against.
+	Value string
 }
 
 // AuditConfig contains options to configure the audit system.
@@ -75,8 +433,85 @@ type AuditConfig struct {
 	// ConfigMapReferenceName is the name of the reference for the ConfigMap containing
 	// auditing configuration to apply to shoot nodes.
 	ConfigMapReferenceName *string
+	// Rules contain structured audit rules which are applied to the nodes instead of the default audit rules.
+	Rules *AuditRules
+}
+
+// AuditRules contain structured audit rules which are rendered into audit rules files on the nodes.
+type AuditRules struct {
+	// FileWatches contain watches auditing the accesses to files and directories.
+	FileWatches []AuditFileWatch
+	// SyscallRules contain rules auditing system calls.
+	SyscallRules []AuditSyscallRule
+	// ExclusionRules contain rules suppressing audit records.
+	ExclusionRules []AuditExclusionRule
+}
+
+// AuditFileWatch audits the accesses to a file or to the files of a directory.
+type AuditFileWatch struct {
+	// Path is the absolute path of the watched file or directory.
+	Path string
+	// Permissions are the kinds of accesses which are audited.
+	Permissions string
+	// Key is the key the audit records of the watch are tagged with.
+	Key *string
+}
+
+// AuditSyscallRule audits the calls of system calls.
+type AuditSyscallRule struct {
+	// Arch is the architecture of the audited system calls.
+	Arch *AuditArch
+	// Syscalls are the names of the audited system calls.
+	Syscalls []string
+	// Filters restrict the audited system calls to the ones whose fields match all filters.
+	Filters []AuditFieldFilter
+	// Key is the key the audit records of the rule are tagged with.
+	Key *string
+}
+
+// AuditExclusionRule suppresses the audit records whose fields match all of its filters.
+type AuditExclusionRule struct {
+	// Filters select the suppressed audit records.
+	Filters []AuditFieldFilter
+}
+
+// AuditFieldFilter compares a field of the audit records with a value.
+type AuditFieldFilter struct {
+	// Field is the name of the compared field, e.g. "auid" or "exe".
+	Field string
+	// Operator is the operator used for comparing the field with the value.
+	Operator *AuditFilterOperator
+	// Value is the value the field is compared with.
+	Value string
 }
 
+// Protocol is the protocol used for sending log messages to a target server.
+type Protocol string
+
+const (
+	// ProtocolRELP specifies the reliable event logging protocol, which is implemented by the omrelp module.
+	ProtocolRELP Protocol = "relp"
+	// ProtocolTCP specifies syslog over TCP with octet-counted framing, optionally secured with TLS as defined in
+	// RFC 5425, which is implemented by the omfwd module.
+	ProtocolTCP Protocol = "tcp"
+	// ProtocolHTTP specifies log messages in JSON format sent via http requests, optionally secured with TLS, which
+	// is implemented by the omhttp module.
+	ProtocolHTTP Protocol = "http"
+	// ProtocolOTLP specifies log messages converted into OpenTelemetry log records sent via OTLP/HTTP with JSON
+	// encoding, which is implemented by the omhttp module.
+	ProtocolOTLP Protocol = "otlp"
+)
+
+// HTTPBatchFormat is the format in which the log messages of a batch are combined into the body of an http request.
+type HTTPBatchFormat string
+
+const (
+	// HTTPBatchFormatNewline specifies that the log messages of a batch are separated by newlines.
+	HTTPBatchFormatNewline HTTPBatchFormat = "newline"
+	// HTTPBatchFormatJSONArray specifies that the log messages of a batch are combined into a JSON array.
+	HTTPBatchFormatJSONArray HTTPBatchFormat = "jsonarray"
+)
+
 // AuthMode is the type of authentication mode that can be used for the rsyslog relp connection to the target server.
 type AuthMode string
 
@@ -97,6 +532,101 @@ const (
 	TLSLibGnuTLS = "gnutls"
 )
 
+// OutputFormat is the format of the log messages sent to the target servers.
+type OutputFormat string
+
+const (
+	// OutputFormatRFC5424 specifies the syslog protocol format as defined in RFC 5424. The shoot metadata is sent
+	// as structured data.
+	OutputFormatRFC5424 OutputFormat = "rfc5424"
+	// OutputFormatRFC3164 specifies the BSD syslog format as defined in RFC 3164.
+	OutputFormatRFC3164 OutputFormat = "rfc3164"
+	// OutputFormatJSON specifies a JSON object containing the shoot metadata and the syslog properties of the message.
+	OutputFormatJSON OutputFormat = "json"
+)
+
+// InputMode is the input module used by rsyslog to read log messages on the nodes.
+type InputMode string
+
+const (
+	// InputModeImuxsock specifies that log messages are read from the syslog socket of journald.
+	InputModeImuxsock InputMode = "imuxsock"
+	// InputModeImjournal specifies that log messages are read from the journal.
+	InputModeImjournal InputMode = "imjournal"
+)
+
+// MessageProperty is a property of the log messages which can be matched in logging rules.
+type MessageProperty string
+
+const (
+	// MessagePropertyFacility is the name of the syslog facility of the log message, e.g. "authpriv".
+	MessagePropertyFacility MessageProperty = "facility"
+	// MessagePropertyHostname is the hostname of the log message.
+	MessagePropertyHostname MessageProperty = "hostname"
+	// MessagePropertySyslogTag is the syslog tag of the log message, e.g. "kubelet[1234]:".
+	MessagePropertySyslogTag MessageProperty = "syslogtag"
+	// MessagePropertyAppName is the app-name of the log message as defined in RFC 5424.
+	MessagePropertyAppName MessageProperty = "app-name"
+	// MessagePropertyProcID is the id of the process which logged the message.
+	MessagePropertyProcID MessageProperty = "procid"
+	// MessagePropertyProgramName is the name of the program which logged the message.
+	MessagePropertyProgramName MessageProperty = "programname"
+	// MessagePropertyMessage is the message content of the log message.
+	MessagePropertyMessage MessageProperty = "msg"
+)
+
+// RedactionPreset is the name of a built-in pattern for redacting sensitive data in log messages.
+type RedactionPreset string
+
+const (
+	// RedactionPresetJWT matches JSON Web Tokens.
+	RedactionPresetJWT RedactionPreset = "jwt"
+	// RedactionPresetAWSAccessKey matches AWS access key IDs.
+	RedactionPresetAWSAccessKey RedactionPreset = "awsAccessKey"
+	// RedactionPresetEmail matches email addresses.
+	RedactionPresetEmail RedactionPreset = "email"
+)
+
+// PropertyMatchOperator is the operator used for matching a property of the log messages.
+type PropertyMatchOperator string
+
+const (
+	// PropertyMatchOperatorEquals matches if the property is equal to the value.
+	PropertyMatchOperatorEquals PropertyMatchOperator = "equals"
+	// PropertyMatchOperatorStartsWith matches if the property starts with the value.
+	PropertyMatchOperatorStartsWith PropertyMatchOperator = "startsWith"
+	// PropertyMatchOperatorRegex matches if the property matches the POSIX ERE regular expression in the value.
+	PropertyMatchOperatorRegex PropertyMatchOperator = "regex"
+)
+
+// AuditArch is the architecture of the system calls audited by an audit rule.
+type AuditArch string
+
+const (
+	// AuditArchB64 specifies the 64-bit system calls.
+	AuditArchB64 AuditArch = "b64"
+	// AuditArchB32 specifies the 32-bit system calls.
+	AuditArchB32 AuditArch = "b32"
+)
+
+// AuditFilterOperator is the operator used for comparing a field of the audit records with a value.
+type AuditFilterOperator string
+
+const (
+	// AuditFilterOperatorEquals matches if the field is equal to the value.
+	AuditFilterOperatorEquals AuditFilterOperator = "equals"
+	// AuditFilterOperatorNotEquals matches if the field is not equal to the value.
+	AuditFilterOperatorNotEquals AuditFilterOperator = "notEquals"
+	// AuditFilterOperatorLessThan matches if the field is less than the value.
+	AuditFilterOperatorLessThan AuditFilterOperator = "lessThan"
+	// AuditFilterOperatorLessThanOrEqual matches if the field is less than or equal to the value.
+	AuditFilterOperatorLessThanOrEqual AuditFilterOperator = "lessThanOrEqual"
+	// AuditFilterOperatorGreaterThan matches if the field is greater than the value.
+	AuditFilterOperatorGreaterThan AuditFilterOperator = "greaterThan"
+	// AuditFilterOperatorGreaterThanOrEqual matches if the field is greater than or equal to the value.
+	AuditFilterOperatorGreaterThanOrEqual AuditFilterOperator = "greaterThanOrEqual"
+)
+
 // MessageContent defines regular expressions for including and excluding logs based on their message content.
 type MessageContent struct {
 	// Regex is a regular expression to match the message content of logs that should be sent to the target server.
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog"
+)
+
+// Convert_Pointer_intstr_IntOrString_To_Pointer_int converts a syslog severity given as numerical code or as name to
+// its numerical code.
+func Convert_Pointer_intstr_IntOrString_To_Pointer_int(in **intstr.IntOrString, out **int, _ conversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+
+	if (*in).Type == intstr.Int {
+		*out = ptr.To((*in).IntValue())
+		return nil
+	}
+
+	code := slices.Index(rsyslog.SeverityNames, (*in).StrVal)
+	if code == -1 {
+		return fmt.Errorf("unknown severity %q, supported names are %q", (*in).StrVal, rsyslog.SeverityNames)
+	}
+	*out = &code
+	return nil
+}
+
+// Convert_Pointer_int_To_Pointer_intstr_IntOrString converts the numerical code of a syslog severity to an
+// IntOrString.
+func Convert_Pointer_int_To_Pointer_intstr_IntOrString(in **int, out **intstr.IntOrString, _ conversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+
+	*out = ptr.To(intstr.FromInt32(int32(**in))) // #nosec G115 -- severities are validated to be between 0 and 7.
+	return nil
+}
+
+// Convert_v1alpha1_Condition_To_rsyslog_Condition converts a Condition. It has to be defined manually, as the
+// generator does not detect that the recursive type has a different memory layout than its internal counterpart.
+func Convert_v1alpha1_Condition_To_rsyslog_Condition(in *Condition, out *rsyslog.Condition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Condition_To_rsyslog_Condition(in, out, s)
+}
+
+// Convert_rsyslog_Condition_To_v1alpha1_Condition converts a Condition. It has to be defined manually, as the
+// generator does not detect that the recursive type has a different memory layout than its internal counterpart.
+func Convert_rsyslog_Condition_To_v1alpha1_Condition(in *rsyslog.Condition, out *Condition, s conversion.Scope) error {
+	return autoConvert_rsyslog_Condition_To_v1alpha1_Condition(in, out, s)
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog"
+	. "github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog/v1alpha1"
+)
+
+var _ = Describe("RsyslogRelpConfig conversion", func() {
+	Describe("severity conversion", func() {
+		It("should convert severities given as numerical codes or names", func() {
+			in := &LoggingRule{
+				Severity: ptr.To(intstr.FromInt32(5)),
+				SeverityRange: &SeverityRange{
+					Min: ptr.To(intstr.FromString("err")),
+					Max: ptr.To(intstr.FromString("warning")),
+				},
+				Condition: &Condition{AnyOf: []Condition{
+					{Severity: ptr.To(intstr.FromString("crit"))},
+					{Not: &Condition{Severity: ptr.To(intstr.FromInt32(6))}},
+				}},
+				Transformations: []Transformation{
+					{Severity: ptr.To(intstr.FromString("notice"))},
+				},
+			}
+			out := &rsyslog.LoggingRule{}
+
+			Expect(Convert_v1alpha1_LoggingRule_To_rsyslog_LoggingRule(in, out, nil)).To(Succeed())
+			Expect(out).To(Equal(&rsyslog.LoggingRule{
+				Severity:      ptr.To(5),
+				SeverityRange: &rsyslog.SeverityRange{Min: ptr.To(3), Max: ptr.To(4)},
+				Condition: &rsyslog.Condition{AnyOf: []rsyslog.Condition{
+					{Severity: ptr.To(2)},
+					{Not: &rsyslog.Condition{Severity: ptr.To(6)}},
+				}},
+				Transformations: []rsyslog.Transformation{
+					{Severity: ptr.To(5)},
+				},
+			}))
+		})
+
+		It("should fail to convert unknown severity names", func() {
+			in := &LoggingRule{Severity: ptr.To(intstr.FromString("warn"))}
+			out := &rsyslog.LoggingRule{}
+
+			Expect(Convert_v1alpha1_LoggingRule_To_rsyslog_LoggingRule(in, out, nil)).To(MatchError(ContainSubstring(`unknown severity "warn"`)))
+		})
+
+		It("should convert severities to numerical codes", func() {
+			in := &rsyslog.LoggingRule{
+				Severity:  ptr.To(2),
+				Condition: &rsyslog.Condition{Severity: ptr.To(7)},
+			}
+			out := &LoggingRule{}
+
+			Expect(Convert_rsyslog_LoggingRule_To_v1alpha1_LoggingRule(in, out, nil)).To(Succeed())
+			Expect(out).To(Equal(&LoggingRule{
+				Severity:  ptr.To(intstr.FromInt32(2)),
+				Condition: &Condition{Severity: ptr.To(intstr.FromInt32(7))},
+			}))
+		})
+	})
+})
@@ -7,6 +7,7 @@ package v1alpha1_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog/v1alpha1"
 )
@@ -34,4 +35,34 @@ var _ = Describe("RsyslogRelpConfig defaulting", func() {
 			Expect(obj.AuditConfig.Enabled).To(BeFalse())
 		})
 	})
+
+	Describe("queue defaulting", func() {
+		It("should correctly set default values", func() {
+			obj := &RsyslogRelpConfig{}
+			SetObjectDefaults_RsyslogRelpConfig(obj)
+
+			Expect(obj.Queue).To(Equal(&Queue{
+				Size:         ptr.To(100000),
+				MaxDiskSpace: ptr.To("48m"),
+			}))
+		})
+
+		It("should not overwrite values if already set", func() {
+			obj := &RsyslogRelpConfig{
+				Queue: &Queue{
+					Size:          ptr.To(50000),
+					MaxDiskSpace:  ptr.To("1g"),
+					HighWatermark: ptr.To(40000),
+				},
+			}
+
+			SetObjectDefaults_RsyslogRelpConfig(obj)
+
+			Expect(obj.Queue).To(Equal(&Queue{
+				Size:          ptr.To(50000),
+				MaxDiskSpace:  ptr.To("1g"),
+				HighWatermark: ptr.To(40000),
+			}))
+		})
+	})
 })
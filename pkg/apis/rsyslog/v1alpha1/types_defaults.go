@@ -4,6 +4,10 @@
 
 package v1alpha1
 
+import (
+	"k8s.io/utils/ptr"
+)
+
 // SetDefaults_RsyslogRelpConfig sets defaults for the rsyslog relp config.
 func SetDefaults_RsyslogRelpConfig(obj *RsyslogRelpConfig) {
 	if obj.AuditConfig == nil {
@@ -11,4 +15,17 @@ func SetDefaults_RsyslogRelpConfig(obj *RsyslogRelpConfig) {
 			Enabled: true,
 		}
 	}
+	if obj.Queue == nil {
+		obj.Queue = &Queue{}
+	}
+}
+
+// SetDefaults_Queue sets defaults for the queue of the rsyslog relp actions.
+func SetDefaults_Queue(obj *Queue) {
+	if obj.Size == nil {
+		obj.Size = ptr.To(100000)
+	}
+	if obj.MaxDiskSpace == nil {
+		obj.MaxDiskSpace = ptr.To("48m")
+	}
 }
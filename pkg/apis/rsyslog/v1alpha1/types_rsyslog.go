@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +genclient
@@ -19,16 +20,30 @@ type RsyslogRelpConfig struct {
 	Target string `json:"target"`
 	// Port is the TCP port to use when connecting to the target server.
 	Port int `json:"port"`
+	// Protocol is the protocol used for sending log messages to the target server.
+	// Possible values are "relp", "tcp", "http" or "otlp".
+	// If the field is omitted, log messages are sent via relp.
+	// +optional
+	Protocol *Protocol `json:"protocol,omitempty"`
+	// HTTP contains options for sending log messages to an http ingestion endpoint of the target server.
+	// Can only be set if Protocol is "http" or "otlp".
+	// +optional
+	HTTP *HTTP `json:"http,omitempty"`
 	// LoggingRules contain a list of LoggingRules that are used to determine which logs are
 	// sent to the target server by the the rsyslog relp action.
 	LoggingRules []LoggingRule `json:"loggingRules,omitempty"`
+	// ExcludeRules contain a list of LoggingRules that are used to determine which logs are discarded before
+	// they are sent to any of the target servers.
+	// +optional
+	ExcludeRules []LoggingRule `json:"excludeRules,omitempty"`
 	// TLS hods the TLS config.
 	// +optional
 	TLS *TLS `json:"tls,omitempty"`
 	// RebindInterval is the rebind interval for the rsyslog relp action.
 	// +optional
 	RebindInterval *int `json:"rebindInterval,omitempty"`
-	// Timeout is the connection timeout for the rsyslog relp action.
+	// Timeout is the connection timeout for the rsyslog relp action. If the target server is reached via http or otlp,
+	// it is the timeout of the requests.
 	// +optional
 	Timeout *int `json:"timeout,omitempty"`
 	// ResumeRetryCount is the resume retry count for the rsyslog relp action.
@@ -42,6 +57,347 @@ type RsyslogRelpConfig struct {
 	// can be forwarded via rsyslog to the target RELP server.
 	// +optional
 	AuditConfig *AuditConfig `json:"auditConfig,omitempty"`
+	// AdditionalTargets contain a list of further target servers to which logs are forwarded via relp
+	// in addition to the target server configured above.
+	// +optional
+	AdditionalTargets []RelpTarget `json:"additionalTargets,omitempty"`
+	// FailoverTarget is a standby target server to which logs are forwarded only while the rsyslog relp action
+	// of the primary target server is suspended.
+	// +optional
+	FailoverTarget *FailoverTarget `json:"failoverTarget,omitempty"`
+	// Queue contains options for the disk-assisted queues of the rsyslog relp actions.
+	// +optional
+	Queue *Queue `json:"queue,omitempty"`
+	// OutputFormat is the format of the log messages sent to the target servers.
+	// Possible values are "rfc5424", "rfc3164" or "json".
+	// If the field is omitted, log messages are sent as a space separated list of the shoot metadata
+	// and the syslog properties of the message.
+	// +optional
+	OutputFormat *OutputFormat `json:"outputFormat,omitempty"`
+	// StaticMetadata contains additional key/value pairs which are added to every log message sent to the
+	// target servers next to the project name, shoot name and shoot UID.
+	// +optional
+	StaticMetadata map[string]string `json:"staticMetadata,omitempty"`
+	// NodeMetadata contains options for adding metadata about the node and its worker pool to every log message
+	// sent to the target servers.
+	// +optional
+	NodeMetadata *NodeMetadata `json:"nodeMetadata,omitempty"`
+	// WorkerPools contain overrides of the configuration above for the nodes of individual worker pools.
+	// +optional
+	WorkerPools []WorkerPool `json:"workerPools,omitempty"`
+	// Input contains options for the input from which rsyslog reads log messages on the nodes.
+	// +optional
+	Input *Input `json:"input,omitempty"`
+	// ContainerLogs contains options for forwarding the logs of the containers running on the nodes.
+	// +optional
+	ContainerLogs *ContainerLogs `json:"containerLogs,omitempty"`
+	// KernelLogs contains options for forwarding the messages of the kernel ring buffer of the nodes.
+	// +optional
+	KernelLogs *KernelLogs `json:"kernelLogs,omitempty"`
+	// Vali contains options for pushing the log messages sent to the target server to the Vali instance of the shoot.
+	// +optional
+	Vali *Vali `json:"vali,omitempty"`
+	// LocalCopy contains options for writing the log messages sent to the target server to a file on the nodes.
+	// +optional
+	LocalCopy *LocalCopy `json:"localCopy,omitempty"`
+	// Redaction contains rules for masking sensitive data, e.g. secrets or personal data, in the log messages before
+	// they are sent to the target servers. The rules are applied in order.
+	// +optional
+	Redaction []RedactionRule `json:"redaction,omitempty"`
+	// AuditStream contains options for sending the audit events of the nodes to the target server separately from
+	// the other log messages.
+	// +optional
+	AuditStream *AuditStream `json:"auditStream,omitempty"`
+}
+
+// AuditStream contains options for sending the audit events of the nodes to the target server via a dedicated
+// rsyslog relp action and queue, which blocks new audit events instead of discarding them when it is full.
+type AuditStream struct {
+	// Enabled determines whether the audit events are sent via a dedicated rsyslog relp action and queue.
+	Enabled bool `json:"enabled"`
+	// Port overrides the TCP port to use when sending the audit events to the target server.
+	// If the field is omitted, the port of the target server is used.
+	// +optional
+	Port *int `json:"port,omitempty"`
+	// Queue contains options for the disk-assisted queue of the audit stream.
+	// +optional
+	Queue *AuditStreamQueue `json:"queue,omitempty"`
+}
+
+// AuditStreamQueue contains options for the disk-assisted queue of the audit stream.
+type AuditStreamQueue struct {
+	// Size is the maximum number of audit events held in memory by the queue.
+	// If the field is omitted, the queue holds up to 100000 audit events in memory.
+	// +optional
+	Size *int `json:"size,omitempty"`
+	// MaxDiskSpace is the maximum disk space the queue may use for spooling audit events, e.g. "512m" or "1g".
+	// If the field is omitted, the queue may use up to 48m of disk space.
+	// +optional
+	MaxDiskSpace *string `json:"maxDiskSpace,omitempty"`
+}
+
+// RedactionRule defines a pattern which is replaced in the log messages before they are sent to the target servers.
+// Exactly one of Preset or Pattern has to be set.
+type RedactionRule struct {
+	// Preset is the name of a built-in pattern. Supported values are "jwt", "awsAccessKey" and "email".
+	// +optional
+	Preset *RedactionPreset `json:"preset,omitempty"`
+	// Pattern is a POSIX ERE regular expression restricted to the syntax which jq interprets in the same way. All of
+	// its matches in the log messages are replaced.
+	// +optional
+	Pattern *string `json:"pattern,omitempty"`
+	// Replacement is the text the matches are replaced with.
+	// Defaults to "[REDACTED]".
+	// +optional
+	Replacement *string `json:"replacement,omitempty"`
+}
+
+// LocalCopy contains options for writing the log messages sent to the target server to a file on the nodes.
+type LocalCopy struct {
+	// Enabled determines whether the log messages sent to the target server are also written to a file on the nodes.
+	Enabled bool `json:"enabled"`
+	// MaxSize is the size of the file at which it is rotated, e.g. "100m" or "1g".
+	// If the field is omitted, the file is rotated at a size of 100m.
+	// +optional
+	MaxSize *string `json:"maxSize,omitempty"`
+	// MaxFiles is the number of rotated files which are kept on the nodes.
+	// If the field is omitted, 5 rotated files are kept.
+	// +optional
+	MaxFiles *int `json:"maxFiles,omitempty"`
+	// MaxAge is the age in minutes after which rotated files are deleted from the nodes.
+	// If the field is omitted, rotated files are only deleted once more than maxFiles rotated files exist.
+	// +optional
+	MaxAge *int `json:"maxAge,omitempty"`
+}
+
+// Vali contains options for pushing the log messages sent to the target server to the Vali instance of the shoot.
+type Vali struct {
+	// Enabled determines whether the log messages sent to the target server are also pushed to the Vali instance of
+	// the shoot in the seed, so that they can be viewed in the Plutono instance of the shoot.
+	Enabled bool `json:"enabled"`
+}
+
+// KernelLogs contains options for forwarding the messages of the kernel ring buffer of the nodes.
+type KernelLogs struct {
+	// Enabled determines whether the messages of the kernel ring buffer are forwarded to the target server.
+	Enabled bool `json:"enabled"`
+	// LoggingRules contain a list of LoggingRules that are used to determine which kernel messages are
+	// sent to the target server. If empty, all kernel messages are sent.
+	// +optional
+	LoggingRules []LoggingRule `json:"loggingRules,omitempty"`
+}
+
+// ContainerLogs contains options for forwarding the logs of the containers running on the nodes.
+type ContainerLogs struct {
+	// Enabled determines whether the logs of the containers running on the nodes are forwarded to the target server.
+	Enabled bool `json:"enabled"`
+	// Namespaces contain the names of the namespaces whose container logs are forwarded. If empty, the container
+	// logs of all namespaces are forwarded.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// PodSelector selects the pods whose container logs are forwarded by their labels. If not set, the container
+	// logs of all pods are forwarded.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// Input contains options for the input from which rsyslog reads log messages on the nodes.
+type Input struct {
+	// Mode is the input module used by rsyslog to read log messages on the nodes.
+	// Possible values are "imuxsock" or "imjournal".
+	// If the field is omitted, log messages are read from the syslog socket of journald via imuxsock.
+	// +optional
+	Mode *InputMode `json:"mode,omitempty"`
+	// ReplayJournal determines whether the log messages already stored in the journal are forwarded when rsyslog
+	// reads from the journal for the first time on a node. Can only be set if Mode is "imjournal".
+	// +optional
+	ReplayJournal *bool `json:"replayJournal,omitempty"`
+	// JournalFields contain names of journal fields, e.g. "_SYSTEMD_UNIT", which are added to every log message
+	// sent to the target servers. Can only be set if Mode is "imjournal".
+	// +optional
+	JournalFields []string `json:"journalFields,omitempty"`
+}
+
+// WorkerPool contains overrides of the rsyslog relp configuration for the nodes of a worker pool.
+// Fields which are omitted are taken from the rsyslog relp configuration. The connection settings of the target server,
+// e.g. its protocol and tls options, cannot be overridden.
+type WorkerPool struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// Target overrides the target server to connect to via relp.
+	// +optional
+	Target *string `json:"target,omitempty"`
+	// Port overrides the TCP port to use when connecting to the target server.
+	// +optional
+	Port *int `json:"port,omitempty"`
+	// LoggingRules override the LoggingRules that are used to determine which logs are
+	// sent to the target server by the rsyslog relp action.
+	// +optional
+	LoggingRules []LoggingRule `json:"loggingRules,omitempty"`
+	// AuditConfig overrides the configuration of node level auditing.
+	// +optional
+	AuditConfig *AuditConfig `json:"auditConfig,omitempty"`
+	// Queue overrides the options for the disk-assisted queues of the rsyslog relp actions.
+	// +optional
+	Queue *Queue `json:"queue,omitempty"`
+}
+
+// NodeMetadata contains options for adding metadata about the node and its worker pool to the log messages.
+type NodeMetadata struct {
+	// Enabled determines whether the worker pool name, machine type, zones, region, seed name and kubernetes version
+	// of the node are added to the log messages.
+	Enabled bool `json:"enabled"`
+}
+
+// Queue contains options for the disk-assisted queues which buffer logs while a target server is not reachable.
+// The options apply to the queue of each target server.
+type Queue struct {
+	// Size is the maximum number of messages held in memory by the queue.
+	// Defaults to 100000.
+	// +optional
+	Size *int `json:"size,omitempty"`
+	// MaxDiskSpace is the maximum disk space the queue may use for spooling messages, e.g. "48m" or "1g".
+	// Defaults to "48m".
+	// +optional
+	MaxDiskSpace *string `json:"maxDiskSpace,omitempty"`
+	// HighWatermark is the number of messages in the queue at which it starts spooling messages to disk.
+	// If the field is omitted, the rsyslog default of 90% of the queue size is used.
+	// +optional
+	HighWatermark *int `json:"highWatermark,omitempty"`
+	// LowWatermark is the number of messages in the queue at which it stops spooling messages to disk.
+	// If the field is omitted, the rsyslog default of 70% of the queue size is used.
+	// +optional
+	LowWatermark *int `json:"lowWatermark,omitempty"`
+	// DiscardMark is the number of messages in the queue at which messages with a severity of DiscardSeverity
+	// or less important are discarded.
+	// If the field is omitted, the rsyslog default of 98% of the queue size is used.
+	// +optional
+	DiscardMark *int `json:"discardMark,omitempty"`
+	// DiscardSeverity is the severity from which messages are discarded when the DiscardMark is reached.
+	// If the field is omitted, the rsyslog default is used.
+	// +optional
+	DiscardSeverity *int `json:"discardSeverity,omitempty"`
+	// DequeueBatchSize is the maximum number of messages that are dequeued and sent to the target server at once.
+	// If the field is omitted, the rsyslog default is used.
+	// +optional
+	DequeueBatchSize *int `json:"dequeueBatchSize,omitempty"`
+	// WorkerThreads is the maximum number of worker threads processing the queue.
+	// If the field is omitted, the rsyslog default is used.
+	// +optional
+	WorkerThreads *int `json:"workerThreads,omitempty"`
+}
+
+// FailoverTarget contains options for a standby target server which takes over while the primary target server
+// is not reachable.
+type FailoverTarget struct {
+	// Target is the target server to connect to via relp.
+	Target string `json:"target"`
+	// Port is the TCP port to use when connecting to the target server.
+	Port int `json:"port"`
+	// Protocol is the protocol used for sending log messages to the target server.
+	// Possible values are "relp", "tcp", "http" or "otlp".
+	// If the field is omitted, log messages are sent via relp.
+	// +optional
+	Protocol *Protocol `json:"protocol,omitempty"`
+	// HTTP contains options for sending log messages to an http ingestion endpoint of the target server.
+	// Can only be set if Protocol is "http" or "otlp".
+	// +optional
+	HTTP *HTTP `json:"http,omitempty"`
+	// TLS hods the TLS config.
+	// +optional
+	TLS *TLS `json:"tls,omitempty"`
+	// RebindInterval is the rebind interval for the rsyslog relp action.
+	// +optional
+	RebindInterval *int `json:"rebindInterval,omitempty"`
+	// Timeout is the connection timeout for the rsyslog relp action. If the target server is reached via http or otlp,
+	// it is the timeout of the requests.
+	// +optional
+	Timeout *int `json:"timeout,omitempty"`
+	// ResumeRetryCount is the resume retry count for the rsyslog relp action.
+	// +optional
+	ResumeRetryCount *int `json:"resumeRetryCount,omitempty"`
+	// ReportSuspensionContinuation determines whether suspension continuation in the relp action
+	// should be reported.
+	// +optional
+	ReportSuspensionContinuation *bool `json:"reportSuspensionContinuation,omitempty"`
+}
+
+// RelpTarget contains options for an additional target server to which logs are forwarded via relp.
+// Each RelpTarget is served by its own rsyslog relp action with a dedicated queue.
+type RelpTarget struct {
+	// Name is the unique name of the target. It is used to name the rsyslog relp action and queue of the target.
+	Name string `json:"name"`
+	// Target is the target server to connect to via relp.
+	Target string `json:"target"`
+	// Port is the TCP port to use when connecting to the target server.
+	Port int `json:"port"`
+	// Protocol is the protocol used for sending log messages to the target server.
+	// Possible values are "relp", "tcp", "http" or "otlp".
+	// If the field is omitted, log messages are sent via relp.
+	// +optional
+	Protocol *Protocol `json:"protocol,omitempty"`
+	// HTTP contains options for sending log messages to an http ingestion endpoint of the target server.
+	// Can only be set if Protocol is "http" or "otlp".
+	// +optional
+	HTTP *HTTP `json:"http,omitempty"`
+	// LoggingRules contain a list of LoggingRules that are used to determine which logs are
+	// sent to the target server by the rsyslog relp action of the target.
+	LoggingRules []LoggingRule `json:"loggingRules,omitempty"`
+	// TLS hods the TLS config.
+	// +optional
+	TLS *TLS `json:"tls,omitempty"`
+	// RebindInterval is the rebind interval for the rsyslog relp action.
+	// +optional
+	RebindInterval *int `json:"rebindInterval,omitempty"`
+	// Timeout is the connection timeout for the rsyslog relp action. If the target server is reached via http or otlp,
+	// it is the timeout of the requests.
+	// +optional
+	Timeout *int `json:"timeout,omitempty"`
+	// ResumeRetryCount is the resume retry count for the rsyslog relp action.
+	// +optional
+	ResumeRetryCount *int `json:"resumeRetryCount,omitempty"`
+	// ReportSuspensionContinuation determines whether suspension continuation in the relp action
+	// should be reported.
+	// +optional
+	ReportSuspensionContinuation *bool `json:"reportSuspensionContinuation,omitempty"`
+}
+
+// HTTP contains options for sending log messages to an http ingestion endpoint of the target server.
+type HTTP struct {
+	// Path is the path of the ingestion endpoint on the target server, e.g. "services/collector/raw".
+	// If the field is omitted and Protocol is "otlp", the default path of OTLP/HTTP log exports "v1/logs" is used.
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// TokenSecretReferenceName is the name of the reference for the secret containing the value of the
+	// Authorization header sent to the target server, e.g. "Splunk <token>" or "Bearer <token>".
+	// +optional
+	TokenSecretReferenceName *string `json:"tokenSecretReferenceName,omitempty"`
+	// Batch contains options for sending multiple log messages to the target server in a single request.
+	// If the field is omitted, every log message is sent in its own request. Can only be set if Protocol is "http".
+	// +optional
+	Batch *HTTPBatch `json:"batch,omitempty"`
+	// Retry determines whether log messages rejected by the target server with a client error (4xx) are sent again.
+	// The omhttp module only retries requests which failed with a server error (5xx) or did not reach the target
+	// server, and drops log messages rejected with a client error, e.g. 429 Too Many Requests. If enabled, the
+	// rejected log messages are sent again until the target server accepts them.
+	// If the field is omitted, log messages rejected with a client error are dropped.
+	// +optional
+	Retry *bool `json:"retry,omitempty"`
+}
+
+// HTTPBatch contains options for sending multiple log messages to the target server in a single request.
+type HTTPBatch struct {
+	// Format is the format in which the log messages of a batch are combined.
+	// Possible values are "newline" or "jsonarray".
+	// If the field is omitted, the log messages are separated by newlines.
+	// +optional
+	Format *HTTPBatchFormat `json:"format,omitempty"`
+	// MaxSize is the maximum number of log messages in a batch.
+	// +optional
+	MaxSize *int `json:"maxSize,omitempty"`
+	// MaxBytes is the maximum size of a batch in bytes.
+	// +optional
+	MaxBytes *int `json:"maxBytes,omitempty"`
 }
 
 // TLS contains options for the tls connection to the target server.
@@ -71,13 +427,138 @@ type TLS struct {
 // LoggingRule contains options that determines which logs are sent to the target server.
 type LoggingRule struct {
 	// ProgramNames are the names of the programs for which logs are sent to the target server.
+	// The names can contain the wildcards `*`, matching any number of characters, and `?`, matching a single character.
 	// +optional
 	ProgramNames []string `json:"programNames,omitempty"`
-	// Severity determines which logs are sent to the target server based on their severity.
-	Severity *int `json:"severity,omitempty"`
+	// SystemdUnits are the names of the systemd units for which logs are sent to the target server.
+	// Can only be set if the input mode is "imjournal".
+	// +optional
+	SystemdUnits []string `json:"systemdUnits,omitempty"`
+	// Facilities are the names of the syslog facilities, e.g. "kern" or "authpriv", for which logs are sent
+	// to the target server.
+	// +optional
+	Facilities []string `json:"facilities,omitempty"`
+	// Severity determines which logs are sent to the target server based on their severity. Logs with the given
+	// severity or a more severe one, i.e. one with a lower numerical code, are sent.
+	// The severity can be given as numerical code or as name, e.g. 4 or "warning".
+	// +optional
+	Severity *intstr.IntOrString `json:"severity,omitempty"`
+	// SeverityRange determines which logs are sent to the target server based on a range of severities.
+	// Cannot be set together with Severity.
+	// +optional
+	SeverityRange *SeverityRange `json:"severityRange,omitempty"`
 	// MessageContent defines regular expressions for including and excluding logs based on their message content.
 	// +optional
 	MessageContent *MessageContent `json:"messageContent,omitempty"`
+	// PropertyMatchers match properties of the logs, e.g. the hostname or a journal field. Logs are only sent to the
+	// target server if all matchers match.
+	// +optional
+	PropertyMatchers []PropertyMatcher `json:"propertyMatchers,omitempty"`
+	// Condition is a boolean expression over the properties of the logs, which has to be true for logs to be sent to
+	// the target server.
+	// +optional
+	Condition *Condition `json:"condition,omitempty"`
+	// RateLimit limits the number of logs matching the rule which are sent to the target server. Further logs
+	// matching the rule are not sent by this rule, but are still evaluated against the following rules. The limit is
+	// approximate, as it is enforced without synchronizing the worker threads of rsyslog.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+	// Sampling determines that only a sample of the logs matching the rule is sent to the target server. The other
+	// logs matching the rule are not sent by this rule, but are still evaluated against the following rules.
+	// +optional
+	Sampling *Sampling `json:"sampling,omitempty"`
+	// Transformations change properties of the logs matching the rule before they are sent to the target server.
+	// They are applied in order.
+	// +optional
+	Transformations []Transformation `json:"transformations,omitempty"`
+}
+
+// Transformation changes a property of the logs matching a logging rule before they are sent to the target server.
+// Exactly one of its fields has to be set.
+type Transformation struct {
+	// Severity overrides the severity of the logs. The severity can be given as numerical code or as name, e.g. 4 or
+	// "warning".
+	// +optional
+	Severity *intstr.IntOrString `json:"severity,omitempty"`
+	// SyslogTag overrides the syslog tag of the logs, e.g. "audit:".
+	// +optional
+	SyslogTag *string `json:"syslogTag,omitempty"`
+	// AppName overrides the app-name of the logs.
+	// +optional
+	AppName *string `json:"appName,omitempty"`
+	// Field adds a custom field to the logs.
+	// +optional
+	Field *CustomField `json:"field,omitempty"`
+}
+
+// CustomField is a field which is added to the logs sent to the target server.
+type CustomField struct {
+	// Name is the name of the field.
+	Name string `json:"name"`
+	// Value is the value of the field.
+	Value string `json:"value"`
+}
+
+// RateLimit limits the number of logs matching a logging rule which are sent to the target server.
+type RateLimit struct {
+	// Interval is the length of the interval in seconds for which the Burst applies.
+	Interval int `json:"interval"`
+	// Burst is the maximum number of logs matching the rule which are sent to the target server per interval.
+	Burst int `json:"burst"`
+}
+
+// Sampling determines that only a sample of the logs matching a logging rule is sent to the target server.
+type Sampling struct {
+	// OneIn is the number N when keeping one in N of the logs matching the rule, e.g. 10 to send every tenth log.
+	OneIn int `json:"oneIn"`
+}
+
+// Condition is a boolean expression over the properties of the logs. Exactly one of its fields has to be set.
+type Condition struct {
+	// AllOf is true if all of the conditions are true.
+	// +optional
+	AllOf []Condition `json:"allOf,omitempty"`
+	// AnyOf is true if at least one of the conditions is true.
+	// +optional
+	AnyOf []Condition `json:"anyOf,omitempty"`
+	// Not is true if the condition is false.
+	// +optional
+	Not *Condition `json:"not,omitempty"`
+	// Match is true if the property matcher matches.
+	// +optional
+	Match *PropertyMatcher `json:"match,omitempty"`
+	// Severity is true if the syslog severity of the logs is lower than or equal to the given value.
+	// The severity can be given as numerical code or as name, e.g. 4 or "warning".
+	// +optional
+	Severity *intstr.IntOrString `json:"severity,omitempty"`
+}
+
+// SeverityRange is a range of syslog severities. The severities can be given as numerical codes or as names, e.g. 4 or
+// "warning". If Min and Max are equal, only logs with exactly this severity are matched.
+type SeverityRange struct {
+	// Min is the lowest numerical code in the range, i.e. the most severe severity.
+	// +optional
+	Min *intstr.IntOrString `json:"min,omitempty"`
+	// Max is the highest numerical code in the range, i.e. the least severe severity.
+	// +optional
+	Max *intstr.IntOrString `json:"max,omitempty"`
+}
+
+// PropertyMatcher matches a property of the logs against a value.
+type PropertyMatcher struct {
+	// Property is the name of the matched property. Exactly one of Property or JournalField has to be set.
+	// +optional
+	Property *MessageProperty `json:"property,omitempty"`
+	// JournalField is the name of the matched journal field, e.g. "_SYSTEMD_UNIT".
+	// Can only be set if the input mode is "imjournal".
+	// +optional
+	JournalField *string `json:"journalField,omitempty"`
+	// Operator is the operator used for matching the property against the value.
+	// Defaults to "equals".
+	// +optional
+	Operator *PropertyMatchOperator `json:"operator,omitempty"`
+	// Value is the value, prefix or POSIX ERE regular expression the property is matched against.
+	Value string `json:"value"`
 }
 
 // AuditConfig contains options to configure the audit system.
@@ -89,8 +570,99 @@ type AuditConfig struct {
 	// auditing configuration to apply to shoot nodes.
 	// +optional
 	ConfigMapReferenceName *string `json:"configMapReferenceName,omitempty"`
+	// Rules contain structured audit rules which are applied to the nodes instead of the default audit rules.
+	// Cannot be set together with ConfigMapReferenceName.
+	// +optional
+	Rules *AuditRules `json:"rules,omitempty"`
+}
+
+// AuditRules contain structured audit rules which are rendered into audit rules files on the nodes.
+type AuditRules struct {
+	// FileWatches contain watches auditing the accesses to files and directories.
+	// +optional
+	FileWatches []AuditFileWatch `json:"fileWatches,omitempty"`
+	// SyscallRules contain rules auditing system calls.
+	// +optional
+	SyscallRules []AuditSyscallRule `json:"syscallRules,omitempty"`
+	// ExclusionRules contain rules suppressing audit records.
+	// +optional
+	ExclusionRules []AuditExclusionRule `json:"exclusionRules,omitempty"`
 }
 
+// AuditFileWatch audits the accesses to a file or to the files of a directory.
+type AuditFileWatch struct {
+	// Path is the absolute path of the watched file or directory.
+	Path string `json:"path"`
+	// Permissions are the kinds of accesses which are audited, any combination of "r" (read), "w" (write),
+	// "x" (execute) and "a" (attribute change).
+	Permissions string `json:"permissions"`
+	// Key is the key the audit records of the watch are tagged with.
+	// +optional
+	Key *string `json:"key,omitempty"`
+}
+
+// AuditSyscallRule audits the calls of system calls.
+type AuditSyscallRule struct {
+	// Arch is the architecture of the audited system calls. Possible values are "b64" or "b32".
+	// Defaults to "b64".
+	// +optional
+	Arch *AuditArch `json:"arch,omitempty"`
+	// Syscalls are the names of the audited system calls, e.g. "execve".
+	Syscalls []string `json:"syscalls"`
+	// Filters restrict the audited system calls to the ones whose fields match all filters.
+	// +optional
+	Filters []AuditFieldFilter `json:"filters,omitempty"`
+	// Key is the key the audit records of the rule are tagged with.
+	// +optional
+	Key *string `json:"key,omitempty"`
+}
+
+// AuditExclusionRule suppresses the audit records whose fields match all of its filters.
+type AuditExclusionRule struct {
+	// Filters select the suppressed audit records. Only the fields "msgtype", "pid", "uid", "gid", "auid" and "exe"
+	// can be used.
+	Filters []AuditFieldFilter `json:"filters"`
+}
+
+// AuditFieldFilter compares a field of the audit records with a value.
+type AuditFieldFilter struct {
+	// Field is the name of the compared field, e.g. "auid" or "exe".
+	Field string `json:"field"`
+	// Operator is the operator used for comparing the field with the value.
+	// Defaults to "equals".
+	// +optional
+	Operator *AuditFilterOperator `json:"operator,omitempty"`
+	// Value is the value the field is compared with.
+	Value string `json:"value"`
+}
+
+// Protocol is the protocol used for sending log messages to a target server.
+type Protocol string
+
+const (
+	// ProtocolRELP specifies the reliable event logging protocol, which is implemented by the omrelp module.
+	ProtocolRELP Protocol = "relp"
+	// ProtocolTCP specifies syslog over TCP with octet-counted framing, optionally secured with TLS as defined in
+	// RFC 5425, which is implemented by the omfwd module.
+	ProtocolTCP Protocol = "tcp"
+	// ProtocolHTTP specifies log messages in JSON format sent via http requests, optionally secured with TLS, which
+	// is implemented by the omhttp module.
+	ProtocolHTTP Protocol = "http"
+	// ProtocolOTLP specifies log messages converted into OpenTelemetry log records sent via OTLP/HTTP with JSON
+	// encoding, which is implemented by the omhttp module.
+	ProtocolOTLP Protocol = "otlp"
+)
+
+// HTTPBatchFormat is the format in which the log messages of a batch are combined into the body of an http request.
+type HTTPBatchFormat string
+
+const (
+	// HTTPBatchFormatNewline specifies that the log messages of a batch are separated by newlines.
+	HTTPBatchFormatNewline HTTPBatchFormat = "newline"
+	// HTTPBatchFormatJSONArray specifies that the log messages of a batch are combined into a JSON array.
+	HTTPBatchFormatJSONArray HTTPBatchFormat = "jsonarray"
+)
+
 // AuthMode is the type of authentication mode that can be used for the rsyslog relp connection to the target server.
 type AuthMode string
 
@@ -111,6 +683,101 @@ const (
 	TLSLibGnuTLS = "gnutls"
 )
 
+// OutputFormat is the format of the log messages sent to the target servers.
+type OutputFormat string
+
+const (
+	// OutputFormatRFC5424 specifies the syslog protocol format as defined in RFC 5424. The shoot metadata is sent
+	// as structured data.
+	OutputFormatRFC5424 OutputFormat = "rfc5424"
+	// OutputFormatRFC3164 specifies the BSD syslog format as defined in RFC 3164.
+	OutputFormatRFC3164 OutputFormat = "rfc3164"
+	// OutputFormatJSON specifies a JSON object containing the shoot metadata and the syslog properties of the message.
+	OutputFormatJSON OutputFormat = "json"
+)
+
+// InputMode is the input module used by rsyslog to read log messages on the nodes.
+type InputMode string
+
+const (
+	// InputModeImuxsock specifies that log messages are read from the syslog socket of journald.
+	InputModeImuxsock InputMode = "imuxsock"
+	// InputModeImjournal specifies that log messages are read from the journal.
+	InputModeImjournal InputMode = "imjournal"
+)
+
+// MessageProperty is a property of the log messages which can be matched in logging rules.
+type MessageProperty string
+
+const (
+	// MessagePropertyFacility is the name of the syslog facility of the log message, e.g. "authpriv".
+	MessagePropertyFacility MessageProperty = "facility"
+	// MessagePropertyHostname is the hostname of the log message.
+	MessagePropertyHostname MessageProperty = "hostname"
+	// MessagePropertySyslogTag is the syslog tag of the log message, e.g. "kubelet[1234]:".
+	MessagePropertySyslogTag MessageProperty = "syslogtag"
+	// MessagePropertyAppName is the app-name of the log message as defined in RFC 5424.
+	MessagePropertyAppName MessageProperty = "app-name"
+	// MessagePropertyProcID is the id of the process which logged the message.
+	MessagePropertyProcID MessageProperty = "procid"
+	// MessagePropertyProgramName is the name of the program which logged the message.
+	MessagePropertyProgramName MessageProperty = "programname"
+	// MessagePropertyMessage is the message content of the log message.
+	MessagePropertyMessage MessageProperty = "msg"
+)
+
+// RedactionPreset is the name of a built-in pattern for redacting sensitive data in log messages.
+type RedactionPreset string
+
+const (
+	// RedactionPresetJWT matches JSON Web Tokens.
+	RedactionPresetJWT RedactionPreset = "jwt"
+	// RedactionPresetAWSAccessKey matches AWS access key IDs.
+	RedactionPresetAWSAccessKey RedactionPreset = "awsAccessKey"
+	// RedactionPresetEmail matches email addresses.
+	RedactionPresetEmail RedactionPreset = "email"
+)
+
+// PropertyMatchOperator is the operator used for matching a property of the log messages.
+type PropertyMatchOperator string
+
+const (
+	// PropertyMatchOperatorEquals matches if the property is equal to the value.
+	PropertyMatchOperatorEquals PropertyMatchOperator = "equals"
+	// PropertyMatchOperatorStartsWith matches if the property starts with the value.
+	PropertyMatchOperatorStartsWith PropertyMatchOperator = "startsWith"
+	// PropertyMatchOperatorRegex matches if the property matches the POSIX ERE regular expression in the value.
+	PropertyMatchOperatorRegex PropertyMatchOperator = "regex"
+)
+
+// AuditArch is the architecture of the system calls audited by an audit rule.
+type AuditArch string
+
+const (
+	// AuditArchB64 specifies the 64-bit system calls.
+	AuditArchB64 AuditArch = "b64"
+	// AuditArchB32 specifies the 32-bit system calls.
+	AuditArchB32 AuditArch = "b32"
+)
+
+// AuditFilterOperator is the operator used for comparing a field of the audit records with a value.
+type AuditFilterOperator string
+
+const (
+	// AuditFilterOperatorEquals matches if the field is equal to the value.
+	AuditFilterOperatorEquals AuditFilterOperator = "equals"
+	// AuditFilterOperatorNotEquals matches if the field is not equal to the value.
+	AuditFilterOperatorNotEquals AuditFilterOperator = "notEquals"
+	// AuditFilterOperatorLessThan matches if the field is less than the value.
+	AuditFilterOperatorLessThan AuditFilterOperator = "lessThan"
+	// AuditFilterOperatorLessThanOrEqual matches if the field is less than or equal to the value.
+	AuditFilterOperatorLessThanOrEqual AuditFilterOperator = "lessThanOrEqual"
+	// AuditFilterOperatorGreaterThan matches if the field is greater than the value.
+	AuditFilterOperatorGreaterThan AuditFilterOperator = "greaterThan"
+	// AuditFilterOperatorGreaterThanOrEqual matches if the field is greater than or equal to the value.
+	AuditFilterOperatorGreaterThanOrEqual AuditFilterOperator = "greaterThanOrEqual"
+)
+
 // MessageContent defines regular expressions for including and excluding logs based on their message content.
 type MessageContent struct {
 	// Regex is a regular expression to match the message content of logs that should be sent to the target server.
@@ -12,8 +12,10 @@ import (
 	unsafe "unsafe"
 
 	rsyslog "github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -33,6 +35,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditExclusionRule)(nil), (*rsyslog.AuditExclusionRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditExclusionRule_To_rsyslog_AuditExclusionRule(a.(*AuditExclusionRule), b.(*rsyslog.AuditExclusionRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.AuditExclusionRule)(nil), (*AuditExclusionRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_AuditExclusionRule_To_v1alpha1_AuditExclusionRule(a.(*rsyslog.AuditExclusionRule), b.(*AuditExclusionRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditFieldFilter)(nil), (*rsyslog.AuditFieldFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditFieldFilter_To_rsyslog_AuditFieldFilter(a.(*AuditFieldFilter), b.(*rsyslog.AuditFieldFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.AuditFieldFilter)(nil), (*AuditFieldFilter)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_AuditFieldFilter_To_v1alpha1_AuditFieldFilter(a.(*rsyslog.AuditFieldFilter), b.(*AuditFieldFilter), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditFileWatch)(nil), (*rsyslog.AuditFileWatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditFileWatch_To_rsyslog_AuditFileWatch(a.(*AuditFileWatch), b.(*rsyslog.AuditFileWatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.AuditFileWatch)(nil), (*AuditFileWatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_AuditFileWatch_To_v1alpha1_AuditFileWatch(a.(*rsyslog.AuditFileWatch), b.(*AuditFileWatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditRules)(nil), (*rsyslog.AuditRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditRules_To_rsyslog_AuditRules(a.(*AuditRules), b.(*rsyslog.AuditRules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.AuditRules)(nil), (*AuditRules)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_AuditRules_To_v1alpha1_AuditRules(a.(*rsyslog.AuditRules), b.(*AuditRules), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditStream)(nil), (*rsyslog.AuditStream)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditStream_To_rsyslog_AuditStream(a.(*AuditStream), b.(*rsyslog.AuditStream), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.AuditStream)(nil), (*AuditStream)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_AuditStream_To_v1alpha1_AuditStream(a.(*rsyslog.AuditStream), b.(*AuditStream), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditStreamQueue)(nil), (*rsyslog.AuditStreamQueue)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditStreamQueue_To_rsyslog_AuditStreamQueue(a.(*AuditStreamQueue), b.(*rsyslog.AuditStreamQueue), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.AuditStreamQueue)(nil), (*AuditStreamQueue)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_AuditStreamQueue_To_v1alpha1_AuditStreamQueue(a.(*rsyslog.AuditStreamQueue), b.(*AuditStreamQueue), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditSyscallRule)(nil), (*rsyslog.AuditSyscallRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AuditSyscallRule_To_rsyslog_AuditSyscallRule(a.(*AuditSyscallRule), b.(*rsyslog.AuditSyscallRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.AuditSyscallRule)(nil), (*AuditSyscallRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_AuditSyscallRule_To_v1alpha1_AuditSyscallRule(a.(*rsyslog.AuditSyscallRule), b.(*AuditSyscallRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Auditd)(nil), (*rsyslog.Auditd)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Auditd_To_rsyslog_Auditd(a.(*Auditd), b.(*rsyslog.Auditd), scope)
 	}); err != nil {
@@ -43,6 +115,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ContainerLogs)(nil), (*rsyslog.ContainerLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContainerLogs_To_rsyslog_ContainerLogs(a.(*ContainerLogs), b.(*rsyslog.ContainerLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.ContainerLogs)(nil), (*ContainerLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_ContainerLogs_To_v1alpha1_ContainerLogs(a.(*rsyslog.ContainerLogs), b.(*ContainerLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CustomField)(nil), (*rsyslog.CustomField)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CustomField_To_rsyslog_CustomField(a.(*CustomField), b.(*rsyslog.CustomField), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.CustomField)(nil), (*CustomField)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_CustomField_To_v1alpha1_CustomField(a.(*rsyslog.CustomField), b.(*CustomField), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FailoverTarget)(nil), (*rsyslog.FailoverTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FailoverTarget_To_rsyslog_FailoverTarget(a.(*FailoverTarget), b.(*rsyslog.FailoverTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.FailoverTarget)(nil), (*FailoverTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_FailoverTarget_To_v1alpha1_FailoverTarget(a.(*rsyslog.FailoverTarget), b.(*FailoverTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HTTP)(nil), (*rsyslog.HTTP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HTTP_To_rsyslog_HTTP(a.(*HTTP), b.(*rsyslog.HTTP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.HTTP)(nil), (*HTTP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_HTTP_To_v1alpha1_HTTP(a.(*rsyslog.HTTP), b.(*HTTP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HTTPBatch)(nil), (*rsyslog.HTTPBatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HTTPBatch_To_rsyslog_HTTPBatch(a.(*HTTPBatch), b.(*rsyslog.HTTPBatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.HTTPBatch)(nil), (*HTTPBatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_HTTPBatch_To_v1alpha1_HTTPBatch(a.(*rsyslog.HTTPBatch), b.(*HTTPBatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Input)(nil), (*rsyslog.Input)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Input_To_rsyslog_Input(a.(*Input), b.(*rsyslog.Input), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.Input)(nil), (*Input)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_Input_To_v1alpha1_Input(a.(*rsyslog.Input), b.(*Input), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KernelLogs)(nil), (*rsyslog.KernelLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KernelLogs_To_rsyslog_KernelLogs(a.(*KernelLogs), b.(*rsyslog.KernelLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.KernelLogs)(nil), (*KernelLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_KernelLogs_To_v1alpha1_KernelLogs(a.(*rsyslog.KernelLogs), b.(*KernelLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LocalCopy)(nil), (*rsyslog.LocalCopy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LocalCopy_To_rsyslog_LocalCopy(a.(*LocalCopy), b.(*rsyslog.LocalCopy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.LocalCopy)(nil), (*LocalCopy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_LocalCopy_To_v1alpha1_LocalCopy(a.(*rsyslog.LocalCopy), b.(*LocalCopy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoggingRule)(nil), (*rsyslog.LoggingRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LoggingRule_To_rsyslog_LoggingRule(a.(*LoggingRule), b.(*rsyslog.LoggingRule), scope)
 	}); err != nil {
@@ -63,6 +215,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeMetadata)(nil), (*rsyslog.NodeMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeMetadata_To_rsyslog_NodeMetadata(a.(*NodeMetadata), b.(*rsyslog.NodeMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.NodeMetadata)(nil), (*NodeMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_NodeMetadata_To_v1alpha1_NodeMetadata(a.(*rsyslog.NodeMetadata), b.(*NodeMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PropertyMatcher)(nil), (*rsyslog.PropertyMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PropertyMatcher_To_rsyslog_PropertyMatcher(a.(*PropertyMatcher), b.(*rsyslog.PropertyMatcher), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.PropertyMatcher)(nil), (*PropertyMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_PropertyMatcher_To_v1alpha1_PropertyMatcher(a.(*rsyslog.PropertyMatcher), b.(*PropertyMatcher), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Queue)(nil), (*rsyslog.Queue)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Queue_To_rsyslog_Queue(a.(*Queue), b.(*rsyslog.Queue), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.Queue)(nil), (*Queue)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_Queue_To_v1alpha1_Queue(a.(*rsyslog.Queue), b.(*Queue), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RateLimit)(nil), (*rsyslog.RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RateLimit_To_rsyslog_RateLimit(a.(*RateLimit), b.(*rsyslog.RateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.RateLimit)(nil), (*RateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_RateLimit_To_v1alpha1_RateLimit(a.(*rsyslog.RateLimit), b.(*RateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RedactionRule)(nil), (*rsyslog.RedactionRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RedactionRule_To_rsyslog_RedactionRule(a.(*RedactionRule), b.(*rsyslog.RedactionRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.RedactionRule)(nil), (*RedactionRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_RedactionRule_To_v1alpha1_RedactionRule(a.(*rsyslog.RedactionRule), b.(*RedactionRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RelpTarget)(nil), (*rsyslog.RelpTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RelpTarget_To_rsyslog_RelpTarget(a.(*RelpTarget), b.(*rsyslog.RelpTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.RelpTarget)(nil), (*RelpTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_RelpTarget_To_v1alpha1_RelpTarget(a.(*rsyslog.RelpTarget), b.(*RelpTarget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RsyslogRelpConfig)(nil), (*rsyslog.RsyslogRelpConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RsyslogRelpConfig_To_rsyslog_RsyslogRelpConfig(a.(*RsyslogRelpConfig), b.(*rsyslog.RsyslogRelpConfig), scope)
 	}); err != nil {
@@ -73,6 +285,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Sampling)(nil), (*rsyslog.Sampling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Sampling_To_rsyslog_Sampling(a.(*Sampling), b.(*rsyslog.Sampling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.Sampling)(nil), (*Sampling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_Sampling_To_v1alpha1_Sampling(a.(*rsyslog.Sampling), b.(*Sampling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeverityRange)(nil), (*rsyslog.SeverityRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeverityRange_To_rsyslog_SeverityRange(a.(*SeverityRange), b.(*rsyslog.SeverityRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.SeverityRange)(nil), (*SeverityRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_SeverityRange_To_v1alpha1_SeverityRange(a.(*rsyslog.SeverityRange), b.(*SeverityRange), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TLS)(nil), (*rsyslog.TLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_TLS_To_rsyslog_TLS(a.(*TLS), b.(*rsyslog.TLS), scope)
 	}); err != nil {
@@ -83,55 +315,609 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Transformation)(nil), (*rsyslog.Transformation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Transformation_To_rsyslog_Transformation(a.(*Transformation), b.(*rsyslog.Transformation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.Transformation)(nil), (*Transformation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_Transformation_To_v1alpha1_Transformation(a.(*rsyslog.Transformation), b.(*Transformation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Vali)(nil), (*rsyslog.Vali)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Vali_To_rsyslog_Vali(a.(*Vali), b.(*rsyslog.Vali), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.Vali)(nil), (*Vali)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_Vali_To_v1alpha1_Vali(a.(*rsyslog.Vali), b.(*Vali), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPool)(nil), (*rsyslog.WorkerPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerPool_To_rsyslog_WorkerPool(a.(*WorkerPool), b.(*rsyslog.WorkerPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*rsyslog.WorkerPool)(nil), (*WorkerPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_WorkerPool_To_v1alpha1_WorkerPool(a.(*rsyslog.WorkerPool), b.(*WorkerPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((**int)(nil), (**intstr.IntOrString)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_int_To_Pointer_intstr_IntOrString(a.(**int), b.(**intstr.IntOrString), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((**intstr.IntOrString)(nil), (**int)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_Pointer_intstr_IntOrString_To_Pointer_int(a.(**intstr.IntOrString), b.(**int), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*rsyslog.Condition)(nil), (*Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_rsyslog_Condition_To_v1alpha1_Condition(a.(*rsyslog.Condition), b.(*Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*Condition)(nil), (*rsyslog.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Condition_To_rsyslog_Condition(a.(*Condition), b.(*rsyslog.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AuditConfig_To_rsyslog_AuditConfig(in *AuditConfig, out *rsyslog.AuditConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConfigMapReferenceName = (*string)(unsafe.Pointer(in.ConfigMapReferenceName))
+	out.Rules = (*rsyslog.AuditRules)(unsafe.Pointer(in.Rules))
+	return nil
+}
+
+// Convert_v1alpha1_AuditConfig_To_rsyslog_AuditConfig is an autogenerated conversion function.
+func Convert_v1alpha1_AuditConfig_To_rsyslog_AuditConfig(in *AuditConfig, out *rsyslog.AuditConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditConfig_To_rsyslog_AuditConfig(in, out, s)
+}
+
+func autoConvert_rsyslog_AuditConfig_To_v1alpha1_AuditConfig(in *rsyslog.AuditConfig, out *AuditConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConfigMapReferenceName = (*string)(unsafe.Pointer(in.ConfigMapReferenceName))
+	out.Rules = (*AuditRules)(unsafe.Pointer(in.Rules))
+	return nil
+}
+
+// Convert_rsyslog_AuditConfig_To_v1alpha1_AuditConfig is an autogenerated conversion function.
+func Convert_rsyslog_AuditConfig_To_v1alpha1_AuditConfig(in *rsyslog.AuditConfig, out *AuditConfig, s conversion.Scope) error {
+	return autoConvert_rsyslog_AuditConfig_To_v1alpha1_AuditConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditExclusionRule_To_rsyslog_AuditExclusionRule(in *AuditExclusionRule, out *rsyslog.AuditExclusionRule, s conversion.Scope) error {
+	out.Filters = *(*[]rsyslog.AuditFieldFilter)(unsafe.Pointer(&in.Filters))
+	return nil
+}
+
+// Convert_v1alpha1_AuditExclusionRule_To_rsyslog_AuditExclusionRule is an autogenerated conversion function.
+func Convert_v1alpha1_AuditExclusionRule_To_rsyslog_AuditExclusionRule(in *AuditExclusionRule, out *rsyslog.AuditExclusionRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditExclusionRule_To_rsyslog_AuditExclusionRule(in, out, s)
+}
+
+func autoConvert_rsyslog_AuditExclusionRule_To_v1alpha1_AuditExclusionRule(in *rsyslog.AuditExclusionRule, out *AuditExclusionRule, s conversion.Scope) error {
+	out.Filters = *(*[]AuditFieldFilter)(unsafe.Pointer(&in.Filters))
+	return nil
+}
+
+// Convert_rsyslog_AuditExclusionRule_To_v1alpha1_AuditExclusionRule is an autogenerated conversion function.
+func Convert_rsyslog_AuditExclusionRule_To_v1alpha1_AuditExclusionRule(in *rsyslog.AuditExclusionRule, out *AuditExclusionRule, s conversion.Scope) error {
+	return autoConvert_rsyslog_AuditExclusionRule_To_v1alpha1_AuditExclusionRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditFieldFilter_To_rsyslog_AuditFieldFilter(in *AuditFieldFilter, out *rsyslog.AuditFieldFilter, s conversion.Scope) error {
+	out.Field = in.Field
+	out.Operator = (*rsyslog.AuditFilterOperator)(unsafe.Pointer(in.Operator))
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1alpha1_AuditFieldFilter_To_rsyslog_AuditFieldFilter is an autogenerated conversion function.
+func Convert_v1alpha1_AuditFieldFilter_To_rsyslog_AuditFieldFilter(in *AuditFieldFilter, out *rsyslog.AuditFieldFilter, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditFieldFilter_To_rsyslog_AuditFieldFilter(in, out, s)
+}
+
+func autoConvert_rsyslog_AuditFieldFilter_To_v1alpha1_AuditFieldFilter(in *rsyslog.AuditFieldFilter, out *AuditFieldFilter, s conversion.Scope) error {
+	out.Field = in.Field
+	out.Operator = (*AuditFilterOperator)(unsafe.Pointer(in.Operator))
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_rsyslog_AuditFieldFilter_To_v1alpha1_AuditFieldFilter is an autogenerated conversion function.
+func Convert_rsyslog_AuditFieldFilter_To_v1alpha1_AuditFieldFilter(in *rsyslog.AuditFieldFilter, out *AuditFieldFilter, s conversion.Scope) error {
+	return autoConvert_rsyslog_AuditFieldFilter_To_v1alpha1_AuditFieldFilter(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditFileWatch_To_rsyslog_AuditFileWatch(in *AuditFileWatch, out *rsyslog.AuditFileWatch, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Permissions = in.Permissions
+	out.Key = (*string)(unsafe.Pointer(in.Key))
+	return nil
+}
+
+// Convert_v1alpha1_AuditFileWatch_To_rsyslog_AuditFileWatch is an autogenerated conversion function.
+func Convert_v1alpha1_AuditFileWatch_To_rsyslog_AuditFileWatch(in *AuditFileWatch, out *rsyslog.AuditFileWatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditFileWatch_To_rsyslog_AuditFileWatch(in, out, s)
+}
+
+func autoConvert_rsyslog_AuditFileWatch_To_v1alpha1_AuditFileWatch(in *rsyslog.AuditFileWatch, out *AuditFileWatch, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Permissions = in.Permissions
+	out.Key = (*string)(unsafe.Pointer(in.Key))
+	return nil
+}
+
+// Convert_rsyslog_AuditFileWatch_To_v1alpha1_AuditFileWatch is an autogenerated conversion function.
+func Convert_rsyslog_AuditFileWatch_To_v1alpha1_AuditFileWatch(in *rsyslog.AuditFileWatch, out *AuditFileWatch, s conversion.Scope) error {
+	return autoConvert_rsyslog_AuditFileWatch_To_v1alpha1_AuditFileWatch(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditRules_To_rsyslog_AuditRules(in *AuditRules, out *rsyslog.AuditRules, s conversion.Scope) error {
+	out.FileWatches = *(*[]rsyslog.AuditFileWatch)(unsafe.Pointer(&in.FileWatches))
+	out.SyscallRules = *(*[]rsyslog.AuditSyscallRule)(unsafe.Pointer(&in.SyscallRules))
+	out.ExclusionRules = *(*[]rsyslog.AuditExclusionRule)(unsafe.Pointer(&in.ExclusionRules))
+	return nil
+}
+
+// Convert_v1alpha1_AuditRules_To_rsyslog_AuditRules is an autogenerated conversion function.
+func Convert_v1alpha1_AuditRules_To_rsyslog_AuditRules(in *AuditRules, out *rsyslog.AuditRules, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditRules_To_rsyslog_AuditRules(in, out, s)
+}
+
+func autoConvert_rsyslog_AuditRules_To_v1alpha1_AuditRules(in *rsyslog.AuditRules, out *AuditRules, s conversion.Scope) error {
+	out.FileWatches = *(*[]AuditFileWatch)(unsafe.Pointer(&in.FileWatches))
+	out.SyscallRules = *(*[]AuditSyscallRule)(unsafe.Pointer(&in.SyscallRules))
+	out.ExclusionRules = *(*[]AuditExclusionRule)(unsafe.Pointer(&in.ExclusionRules))
+	return nil
+}
+
+// Convert_rsyslog_AuditRules_To_v1alpha1_AuditRules is an autogenerated conversion function.
+func Convert_rsyslog_AuditRules_To_v1alpha1_AuditRules(in *rsyslog.AuditRules, out *AuditRules, s conversion.Scope) error {
+	return autoConvert_rsyslog_AuditRules_To_v1alpha1_AuditRules(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditStream_To_rsyslog_AuditStream(in *AuditStream, out *rsyslog.AuditStream, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Port = (*int)(unsafe.Pointer(in.Port))
+	out.Queue = (*rsyslog.AuditStreamQueue)(unsafe.Pointer(in.Queue))
+	return nil
+}
+
+// Convert_v1alpha1_AuditStream_To_rsyslog_AuditStream is an autogenerated conversion function.
+func Convert_v1alpha1_AuditStream_To_rsyslog_AuditStream(in *AuditStream, out *rsyslog.AuditStream, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditStream_To_rsyslog_AuditStream(in, out, s)
+}
+
+func autoConvert_rsyslog_AuditStream_To_v1alpha1_AuditStream(in *rsyslog.AuditStream, out *AuditStream, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Port = (*int)(unsafe.Pointer(in.Port))
+	out.Queue = (*AuditStreamQueue)(unsafe.Pointer(in.Queue))
+	return nil
+}
+
+// Convert_rsyslog_AuditStream_To_v1alpha1_AuditStream is an autogenerated conversion function.
+func Convert_rsyslog_AuditStream_To_v1alpha1_AuditStream(in *rsyslog.AuditStream, out *AuditStream, s conversion.Scope) error {
+	return autoConvert_rsyslog_AuditStream_To_v1alpha1_AuditStream(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditStreamQueue_To_rsyslog_AuditStreamQueue(in *AuditStreamQueue, out *rsyslog.AuditStreamQueue, s conversion.Scope) error {
+	out.Size = (*int)(unsafe.Pointer(in.Size))
+	out.MaxDiskSpace = (*string)(unsafe.Pointer(in.MaxDiskSpace))
+	return nil
+}
+
+// Convert_v1alpha1_AuditStreamQueue_To_rsyslog_AuditStreamQueue is an autogenerated conversion function.
+func Convert_v1alpha1_AuditStreamQueue_To_rsyslog_AuditStreamQueue(in *AuditStreamQueue, out *rsyslog.AuditStreamQueue, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditStreamQueue_To_rsyslog_AuditStreamQueue(in, out, s)
+}
+
+func autoConvert_rsyslog_AuditStreamQueue_To_v1alpha1_AuditStreamQueue(in *rsyslog.AuditStreamQueue, out *AuditStreamQueue, s conversion.Scope) error {
+	out.Size = (*int)(unsafe.Pointer(in.Size))
+	out.MaxDiskSpace = (*string)(unsafe.Pointer(in.MaxDiskSpace))
+	return nil
+}
+
+// Convert_rsyslog_AuditStreamQueue_To_v1alpha1_AuditStreamQueue is an autogenerated conversion function.
+func Convert_rsyslog_AuditStreamQueue_To_v1alpha1_AuditStreamQueue(in *rsyslog.AuditStreamQueue, out *AuditStreamQueue, s conversion.Scope) error {
+	return autoConvert_rsyslog_AuditStreamQueue_To_v1alpha1_AuditStreamQueue(in, out, s)
+}
+
+func autoConvert_v1alpha1_AuditSyscallRule_To_rsyslog_AuditSyscallRule(in *AuditSyscallRule, out *rsyslog.AuditSyscallRule, s conversion.Scope) error {
+	out.Arch = (*rsyslog.AuditArch)(unsafe.Pointer(in.Arch))
+	out.Syscalls = *(*[]string)(unsafe.Pointer(&in.Syscalls))
+	out.Filters = *(*[]rsyslog.AuditFieldFilter)(unsafe.Pointer(&in.Filters))
+	out.Key = (*string)(unsafe.Pointer(in.Key))
+	return nil
+}
+
+// Convert_v1alpha1_AuditSyscallRule_To_rsyslog_AuditSyscallRule is an autogenerated conversion function.
+func Convert_v1alpha1_AuditSyscallRule_To_rsyslog_AuditSyscallRule(in *AuditSyscallRule, out *rsyslog.AuditSyscallRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AuditSyscallRule_To_rsyslog_AuditSyscallRule(in, out, s)
+}
+
+func autoConvert_rsyslog_AuditSyscallRule_To_v1alpha1_AuditSyscallRule(in *rsyslog.AuditSyscallRule, out *AuditSyscallRule, s conversion.Scope) error {
+	out.Arch = (*AuditArch)(unsafe.Pointer(in.Arch))
+	out.Syscalls = *(*[]string)(unsafe.Pointer(&in.Syscalls))
+	out.Filters = *(*[]AuditFieldFilter)(unsafe.Pointer(&in.Filters))
+	out.Key = (*string)(unsafe.Pointer(in.Key))
+	return nil
+}
+
+// Convert_rsyslog_AuditSyscallRule_To_v1alpha1_AuditSyscallRule is an autogenerated conversion function.
+func Convert_rsyslog_AuditSyscallRule_To_v1alpha1_AuditSyscallRule(in *rsyslog.AuditSyscallRule, out *AuditSyscallRule, s conversion.Scope) error {
+	return autoConvert_rsyslog_AuditSyscallRule_To_v1alpha1_AuditSyscallRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_Auditd_To_rsyslog_Auditd(in *Auditd, out *rsyslog.Auditd, s conversion.Scope) error {
+	out.AuditRules = in.AuditRules
+	return nil
+}
+
+// Convert_v1alpha1_Auditd_To_rsyslog_Auditd is an autogenerated conversion function.
+func Convert_v1alpha1_Auditd_To_rsyslog_Auditd(in *Auditd, out *rsyslog.Auditd, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Auditd_To_rsyslog_Auditd(in, out, s)
+}
+
+func autoConvert_rsyslog_Auditd_To_v1alpha1_Auditd(in *rsyslog.Auditd, out *Auditd, s conversion.Scope) error {
+	out.AuditRules = in.AuditRules
+	return nil
+}
+
+// Convert_rsyslog_Auditd_To_v1alpha1_Auditd is an autogenerated conversion function.
+func Convert_rsyslog_Auditd_To_v1alpha1_Auditd(in *rsyslog.Auditd, out *Auditd, s conversion.Scope) error {
+	return autoConvert_rsyslog_Auditd_To_v1alpha1_Auditd(in, out, s)
+}
+
+func autoConvert_v1alpha1_Condition_To_rsyslog_Condition(in *Condition, out *rsyslog.Condition, s conversion.Scope) error {
+	if in.AllOf != nil {
+		in, out := &in.AllOf, &out.AllOf
+		*out = make([]rsyslog.Condition, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Condition_To_rsyslog_Condition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AllOf = nil
+	}
+	if in.AnyOf != nil {
+		in, out := &in.AnyOf, &out.AnyOf
+		*out = make([]rsyslog.Condition, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Condition_To_rsyslog_Condition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AnyOf = nil
+	}
+	if in.Not != nil {
+		in, out := &in.Not, &out.Not
+		*out = new(rsyslog.Condition)
+		if err := Convert_v1alpha1_Condition_To_rsyslog_Condition(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Not = nil
+	}
+	out.Match = (*rsyslog.PropertyMatcher)(unsafe.Pointer(in.Match))
+	if err := Convert_Pointer_intstr_IntOrString_To_Pointer_int(&in.Severity, &out.Severity, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_rsyslog_Condition_To_v1alpha1_Condition(in *rsyslog.Condition, out *Condition, s conversion.Scope) error {
+	if in.AllOf != nil {
+		in, out := &in.AllOf, &out.AllOf
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			if err := Convert_rsyslog_Condition_To_v1alpha1_Condition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AllOf = nil
+	}
+	if in.AnyOf != nil {
+		in, out := &in.AnyOf, &out.AnyOf
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			if err := Convert_rsyslog_Condition_To_v1alpha1_Condition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AnyOf = nil
+	}
+	if in.Not != nil {
+		in, out := &in.Not, &out.Not
+		*out = new(Condition)
+		if err := Convert_rsyslog_Condition_To_v1alpha1_Condition(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Not = nil
+	}
+	out.Match = (*PropertyMatcher)(unsafe.Pointer(in.Match))
+	if err := Convert_Pointer_int_To_Pointer_intstr_IntOrString(&in.Severity, &out.Severity, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_ContainerLogs_To_rsyslog_ContainerLogs(in *ContainerLogs, out *rsyslog.ContainerLogs, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.PodSelector = (*v1.LabelSelector)(unsafe.Pointer(in.PodSelector))
+	return nil
+}
+
+// Convert_v1alpha1_ContainerLogs_To_rsyslog_ContainerLogs is an autogenerated conversion function.
+func Convert_v1alpha1_ContainerLogs_To_rsyslog_ContainerLogs(in *ContainerLogs, out *rsyslog.ContainerLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContainerLogs_To_rsyslog_ContainerLogs(in, out, s)
+}
+
+func autoConvert_rsyslog_ContainerLogs_To_v1alpha1_ContainerLogs(in *rsyslog.ContainerLogs, out *ContainerLogs, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.PodSelector = (*v1.LabelSelector)(unsafe.Pointer(in.PodSelector))
 	return nil
 }
 
-func autoConvert_v1alpha1_AuditConfig_To_rsyslog_AuditConfig(in *AuditConfig, out *rsyslog.AuditConfig, s conversion.Scope) error {
+// Convert_rsyslog_ContainerLogs_To_v1alpha1_ContainerLogs is an autogenerated conversion function.
+func Convert_rsyslog_ContainerLogs_To_v1alpha1_ContainerLogs(in *rsyslog.ContainerLogs, out *ContainerLogs, s conversion.Scope) error {
+	return autoConvert_rsyslog_ContainerLogs_To_v1alpha1_ContainerLogs(in, out, s)
+}
+
+func autoConvert_v1alpha1_CustomField_To_rsyslog_CustomField(in *CustomField, out *rsyslog.CustomField, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1alpha1_CustomField_To_rsyslog_CustomField is an autogenerated conversion function.
+func Convert_v1alpha1_CustomField_To_rsyslog_CustomField(in *CustomField, out *rsyslog.CustomField, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CustomField_To_rsyslog_CustomField(in, out, s)
+}
+
+func autoConvert_rsyslog_CustomField_To_v1alpha1_CustomField(in *rsyslog.CustomField, out *CustomField, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_rsyslog_CustomField_To_v1alpha1_CustomField is an autogenerated conversion function.
+func Convert_rsyslog_CustomField_To_v1alpha1_CustomField(in *rsyslog.CustomField, out *CustomField, s conversion.Scope) error {
+	return autoConvert_rsyslog_CustomField_To_v1alpha1_CustomField(in, out, s)
+}
+
+func autoConvert_v1alpha1_FailoverTarget_To_rsyslog_FailoverTarget(in *FailoverTarget, out *rsyslog.FailoverTarget, s conversion.Scope) error {
+	out.Target = in.Target
+	out.Port = in.Port
+	out.Protocol = (*rsyslog.Protocol)(unsafe.Pointer(in.Protocol))
+	out.HTTP = (*rsyslog.HTTP)(unsafe.Pointer(in.HTTP))
+	out.TLS = (*rsyslog.TLS)(unsafe.Pointer(in.TLS))
+	out.RebindInterval = (*int)(unsafe.Pointer(in.RebindInterval))
+	out.Timeout = (*int)(unsafe.Pointer(in.Timeout))
+	out.ResumeRetryCount = (*int)(unsafe.Pointer(in.ResumeRetryCount))
+	out.ReportSuspensionContinuation = (*bool)(unsafe.Pointer(in.ReportSuspensionContinuation))
+	return nil
+}
+
+// Convert_v1alpha1_FailoverTarget_To_rsyslog_FailoverTarget is an autogenerated conversion function.
+func Convert_v1alpha1_FailoverTarget_To_rsyslog_FailoverTarget(in *FailoverTarget, out *rsyslog.FailoverTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FailoverTarget_To_rsyslog_FailoverTarget(in, out, s)
+}
+
+func autoConvert_rsyslog_FailoverTarget_To_v1alpha1_FailoverTarget(in *rsyslog.FailoverTarget, out *FailoverTarget, s conversion.Scope) error {
+	out.Target = in.Target
+	out.Port = in.Port
+	out.Protocol = (*Protocol)(unsafe.Pointer(in.Protocol))
+	out.HTTP = (*HTTP)(unsafe.Pointer(in.HTTP))
+	out.TLS = (*TLS)(unsafe.Pointer(in.TLS))
+	out.RebindInterval = (*int)(unsafe.Pointer(in.RebindInterval))
+	out.Timeout = (*int)(unsafe.Pointer(in.Timeout))
+	out.ResumeRetryCount = (*int)(unsafe.Pointer(in.ResumeRetryCount))
+	out.ReportSuspensionContinuation = (*bool)(unsafe.Pointer(in.ReportSuspensionContinuation))
+	return nil
+}
+
+// Convert_rsyslog_FailoverTarget_To_v1alpha1_FailoverTarget is an autogenerated conversion function.
+func Convert_rsyslog_FailoverTarget_To_v1alpha1_FailoverTarget(in *rsyslog.FailoverTarget, out *FailoverTarget, s conversion.Scope) error {
+	return autoConvert_rsyslog_FailoverTarget_To_v1alpha1_FailoverTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_HTTP_To_rsyslog_HTTP(in *HTTP, out *rsyslog.HTTP, s conversion.Scope) error {
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.TokenSecretReferenceName = (*string)(unsafe.Pointer(in.TokenSecretReferenceName))
+	out.Batch = (*rsyslog.HTTPBatch)(unsafe.Pointer(in.Batch))
+	out.Retry = (*bool)(unsafe.Pointer(in.Retry))
+	return nil
+}
+
+// Convert_v1alpha1_HTTP_To_rsyslog_HTTP is an autogenerated conversion function.
+func Convert_v1alpha1_HTTP_To_rsyslog_HTTP(in *HTTP, out *rsyslog.HTTP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HTTP_To_rsyslog_HTTP(in, out, s)
+}
+
+func autoConvert_rsyslog_HTTP_To_v1alpha1_HTTP(in *rsyslog.HTTP, out *HTTP, s conversion.Scope) error {
+	out.Path = (*string)(unsafe.Pointer(in.Path))
+	out.TokenSecretReferenceName = (*string)(unsafe.Pointer(in.TokenSecretReferenceName))
+	out.Batch = (*HTTPBatch)(unsafe.Pointer(in.Batch))
+	out.Retry = (*bool)(unsafe.Pointer(in.Retry))
+	return nil
+}
+
+// Convert_rsyslog_HTTP_To_v1alpha1_HTTP is an autogenerated conversion function.
+func Convert_rsyslog_HTTP_To_v1alpha1_HTTP(in *rsyslog.HTTP, out *HTTP, s conversion.Scope) error {
+	return autoConvert_rsyslog_HTTP_To_v1alpha1_HTTP(in, out, s)
+}
+
+func autoConvert_v1alpha1_HTTPBatch_To_rsyslog_HTTPBatch(in *HTTPBatch, out *rsyslog.HTTPBatch, s conversion.Scope) error {
+	out.Format = (*rsyslog.HTTPBatchFormat)(unsafe.Pointer(in.Format))
+	out.MaxSize = (*int)(unsafe.Pointer(in.MaxSize))
+	out.MaxBytes = (*int)(unsafe.Pointer(in.MaxBytes))
+	return nil
+}
+
+// Convert_v1alpha1_HTTPBatch_To_rsyslog_HTTPBatch is an autogenerated conversion function.
+func Convert_v1alpha1_HTTPBatch_To_rsyslog_HTTPBatch(in *HTTPBatch, out *rsyslog.HTTPBatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HTTPBatch_To_rsyslog_HTTPBatch(in, out, s)
+}
+
+func autoConvert_rsyslog_HTTPBatch_To_v1alpha1_HTTPBatch(in *rsyslog.HTTPBatch, out *HTTPBatch, s conversion.Scope) error {
+	out.Format = (*HTTPBatchFormat)(unsafe.Pointer(in.Format))
+	out.MaxSize = (*int)(unsafe.Pointer(in.MaxSize))
+	out.MaxBytes = (*int)(unsafe.Pointer(in.MaxBytes))
+	return nil
+}
+
+// Convert_rsyslog_HTTPBatch_To_v1alpha1_HTTPBatch is an autogenerated conversion function.
+func Convert_rsyslog_HTTPBatch_To_v1alpha1_HTTPBatch(in *rsyslog.HTTPBatch, out *HTTPBatch, s conversion.Scope) error {
+	return autoConvert_rsyslog_HTTPBatch_To_v1alpha1_HTTPBatch(in, out, s)
+}
+
+func autoConvert_v1alpha1_Input_To_rsyslog_Input(in *Input, out *rsyslog.Input, s conversion.Scope) error {
+	out.Mode = (*rsyslog.InputMode)(unsafe.Pointer(in.Mode))
+	out.ReplayJournal = (*bool)(unsafe.Pointer(in.ReplayJournal))
+	out.JournalFields = *(*[]string)(unsafe.Pointer(&in.JournalFields))
+	return nil
+}
+
+// Convert_v1alpha1_Input_To_rsyslog_Input is an autogenerated conversion function.
+func Convert_v1alpha1_Input_To_rsyslog_Input(in *Input, out *rsyslog.Input, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Input_To_rsyslog_Input(in, out, s)
+}
+
+func autoConvert_rsyslog_Input_To_v1alpha1_Input(in *rsyslog.Input, out *Input, s conversion.Scope) error {
+	out.Mode = (*InputMode)(unsafe.Pointer(in.Mode))
+	out.ReplayJournal = (*bool)(unsafe.Pointer(in.ReplayJournal))
+	out.JournalFields = *(*[]string)(unsafe.Pointer(&in.JournalFields))
+	return nil
+}
+
+// Convert_rsyslog_Input_To_v1alpha1_Input is an autogenerated conversion function.
+func Convert_rsyslog_Input_To_v1alpha1_Input(in *rsyslog.Input, out *Input, s conversion.Scope) error {
+	return autoConvert_rsyslog_Input_To_v1alpha1_Input(in, out, s)
+}
+
+func autoConvert_v1alpha1_KernelLogs_To_rsyslog_KernelLogs(in *KernelLogs, out *rsyslog.KernelLogs, s conversion.Scope) error {
 	out.Enabled = in.Enabled
-	out.ConfigMapReferenceName = (*string)(unsafe.Pointer(in.ConfigMapReferenceName))
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]rsyslog.LoggingRule, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_LoggingRule_To_rsyslog_LoggingRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LoggingRules = nil
+	}
 	return nil
 }
 
-// Convert_v1alpha1_AuditConfig_To_rsyslog_AuditConfig is an autogenerated conversion function.
-func Convert_v1alpha1_AuditConfig_To_rsyslog_AuditConfig(in *AuditConfig, out *rsyslog.AuditConfig, s conversion.Scope) error {
-	return autoConvert_v1alpha1_AuditConfig_To_rsyslog_AuditConfig(in, out, s)
+// Convert_v1alpha1_KernelLogs_To_rsyslog_KernelLogs is an autogenerated conversion function.
+func Convert_v1alpha1_KernelLogs_To_rsyslog_KernelLogs(in *KernelLogs, out *rsyslog.KernelLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KernelLogs_To_rsyslog_KernelLogs(in, out, s)
 }
 
-func autoConvert_rsyslog_AuditConfig_To_v1alpha1_AuditConfig(in *rsyslog.AuditConfig, out *AuditConfig, s conversion.Scope) error {
+func autoConvert_rsyslog_KernelLogs_To_v1alpha1_KernelLogs(in *rsyslog.KernelLogs, out *KernelLogs, s conversion.Scope) error {
 	out.Enabled = in.Enabled
-	out.ConfigMapReferenceName = (*string)(unsafe.Pointer(in.ConfigMapReferenceName))
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]LoggingRule, len(*in))
+		for i := range *in {
+			if err := Convert_rsyslog_LoggingRule_To_v1alpha1_LoggingRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LoggingRules = nil
+	}
 	return nil
 }
 
-// Convert_rsyslog_AuditConfig_To_v1alpha1_AuditConfig is an autogenerated conversion function.
-func Convert_rsyslog_AuditConfig_To_v1alpha1_AuditConfig(in *rsyslog.AuditConfig, out *AuditConfig, s conversion.Scope) error {
-	return autoConvert_rsyslog_AuditConfig_To_v1alpha1_AuditConfig(in, out, s)
+// Convert_rsyslog_KernelLogs_To_v1alpha1_KernelLogs is an autogenerated conversion function.
+func Convert_rsyslog_KernelLogs_To_v1alpha1_KernelLogs(in *rsyslog.KernelLogs, out *KernelLogs, s conversion.Scope) error {
+	return autoConvert_rsyslog_KernelLogs_To_v1alpha1_KernelLogs(in, out, s)
 }
 
-func autoConvert_v1alpha1_Auditd_To_rsyslog_Auditd(in *Auditd, out *rsyslog.Auditd, s conversion.Scope) error {
-	out.AuditRules = in.AuditRules
+func autoConvert_v1alpha1_LocalCopy_To_rsyslog_LocalCopy(in *LocalCopy, out *rsyslog.LocalCopy, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MaxSize = (*string)(unsafe.Pointer(in.MaxSize))
+	out.MaxFiles = (*int)(unsafe.Pointer(in.MaxFiles))
+	out.MaxAge = (*int)(unsafe.Pointer(in.MaxAge))
 	return nil
 }
 
-// Convert_v1alpha1_Auditd_To_rsyslog_Auditd is an autogenerated conversion function.
-func Convert_v1alpha1_Auditd_To_rsyslog_Auditd(in *Auditd, out *rsyslog.Auditd, s conversion.Scope) error {
-	return autoConvert_v1alpha1_Auditd_To_rsyslog_Auditd(in, out, s)
+// Convert_v1alpha1_LocalCopy_To_rsyslog_LocalCopy is an autogenerated conversion function.
+func Convert_v1alpha1_LocalCopy_To_rsyslog_LocalCopy(in *LocalCopy, out *rsyslog.LocalCopy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LocalCopy_To_rsyslog_LocalCopy(in, out, s)
 }
 
-func autoConvert_rsyslog_Auditd_To_v1alpha1_Auditd(in *rsyslog.Auditd, out *Auditd, s conversion.Scope) error {
-	out.AuditRules = in.AuditRules
+func autoConvert_rsyslog_LocalCopy_To_v1alpha1_LocalCopy(in *rsyslog.LocalCopy, out *LocalCopy, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.MaxSize = (*string)(unsafe.Pointer(in.MaxSize))
+	out.MaxFiles = (*int)(unsafe.Pointer(in.MaxFiles))
+	out.MaxAge = (*int)(unsafe.Pointer(in.MaxAge))
 	return nil
 }
 
-// Convert_rsyslog_Auditd_To_v1alpha1_Auditd is an autogenerated conversion function.
-func Convert_rsyslog_Auditd_To_v1alpha1_Auditd(in *rsyslog.Auditd, out *Auditd, s conversion.Scope) error {
-	return autoConvert_rsyslog_Auditd_To_v1alpha1_Auditd(in, out, s)
+// Convert_rsyslog_LocalCopy_To_v1alpha1_LocalCopy is an autogenerated conversion function.
+func Convert_rsyslog_LocalCopy_To_v1alpha1_LocalCopy(in *rsyslog.LocalCopy, out *LocalCopy, s conversion.Scope) error {
+	return autoConvert_rsyslog_LocalCopy_To_v1alpha1_LocalCopy(in, out, s)
 }
 
 func autoConvert_v1alpha1_LoggingRule_To_rsyslog_LoggingRule(in *LoggingRule, out *rsyslog.LoggingRule, s conversion.Scope) error {
 	out.ProgramNames = *(*[]string)(unsafe.Pointer(&in.ProgramNames))
-	out.Severity = (*int)(unsafe.Pointer(in.Severity))
+	out.SystemdUnits = *(*[]string)(unsafe.Pointer(&in.SystemdUnits))
+	out.Facilities = *(*[]string)(unsafe.Pointer(&in.Facilities))
+	if err := Convert_Pointer_intstr_IntOrString_To_Pointer_int(&in.Severity, &out.Severity, s); err != nil {
+		return err
+	}
+	if in.SeverityRange != nil {
+		in, out := &in.SeverityRange, &out.SeverityRange
+		*out = new(rsyslog.SeverityRange)
+		if err := Convert_v1alpha1_SeverityRange_To_rsyslog_SeverityRange(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SeverityRange = nil
+	}
 	out.MessageContent = (*rsyslog.MessageContent)(unsafe.Pointer(in.MessageContent))
+	out.PropertyMatchers = *(*[]rsyslog.PropertyMatcher)(unsafe.Pointer(&in.PropertyMatchers))
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(rsyslog.Condition)
+		if err := Convert_v1alpha1_Condition_To_rsyslog_Condition(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Condition = nil
+	}
+	out.RateLimit = (*rsyslog.RateLimit)(unsafe.Pointer(in.RateLimit))
+	out.Sampling = (*rsyslog.Sampling)(unsafe.Pointer(in.Sampling))
+	if in.Transformations != nil {
+		in, out := &in.Transformations, &out.Transformations
+		*out = make([]rsyslog.Transformation, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Transformation_To_rsyslog_Transformation(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Transformations = nil
+	}
 	return nil
 }
 
@@ -142,8 +928,44 @@ func Convert_v1alpha1_LoggingRule_To_rsyslog_LoggingRule(in *LoggingRule, out *r
 
 func autoConvert_rsyslog_LoggingRule_To_v1alpha1_LoggingRule(in *rsyslog.LoggingRule, out *LoggingRule, s conversion.Scope) error {
 	out.ProgramNames = *(*[]string)(unsafe.Pointer(&in.ProgramNames))
-	out.Severity = (*int)(unsafe.Pointer(in.Severity))
+	out.SystemdUnits = *(*[]string)(unsafe.Pointer(&in.SystemdUnits))
+	out.Facilities = *(*[]string)(unsafe.Pointer(&in.Facilities))
+	if err := Convert_Pointer_int_To_Pointer_intstr_IntOrString(&in.Severity, &out.Severity, s); err != nil {
+		return err
+	}
+	if in.SeverityRange != nil {
+		in, out := &in.SeverityRange, &out.SeverityRange
+		*out = new(SeverityRange)
+		if err := Convert_rsyslog_SeverityRange_To_v1alpha1_SeverityRange(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SeverityRange = nil
+	}
 	out.MessageContent = (*MessageContent)(unsafe.Pointer(in.MessageContent))
+	out.PropertyMatchers = *(*[]PropertyMatcher)(unsafe.Pointer(&in.PropertyMatchers))
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(Condition)
+		if err := Convert_rsyslog_Condition_To_v1alpha1_Condition(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Condition = nil
+	}
+	out.RateLimit = (*RateLimit)(unsafe.Pointer(in.RateLimit))
+	out.Sampling = (*Sampling)(unsafe.Pointer(in.Sampling))
+	if in.Transformations != nil {
+		in, out := &in.Transformations, &out.Transformations
+		*out = make([]Transformation, len(*in))
+		for i := range *in {
+			if err := Convert_rsyslog_Transformation_To_v1alpha1_Transformation(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Transformations = nil
+	}
 	return nil
 }
 
@@ -174,16 +996,267 @@ func Convert_rsyslog_MessageContent_To_v1alpha1_MessageContent(in *rsyslog.Messa
 	return autoConvert_rsyslog_MessageContent_To_v1alpha1_MessageContent(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodeMetadata_To_rsyslog_NodeMetadata(in *NodeMetadata, out *rsyslog.NodeMetadata, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_NodeMetadata_To_rsyslog_NodeMetadata is an autogenerated conversion function.
+func Convert_v1alpha1_NodeMetadata_To_rsyslog_NodeMetadata(in *NodeMetadata, out *rsyslog.NodeMetadata, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeMetadata_To_rsyslog_NodeMetadata(in, out, s)
+}
+
+func autoConvert_rsyslog_NodeMetadata_To_v1alpha1_NodeMetadata(in *rsyslog.NodeMetadata, out *NodeMetadata, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_rsyslog_NodeMetadata_To_v1alpha1_NodeMetadata is an autogenerated conversion function.
+func Convert_rsyslog_NodeMetadata_To_v1alpha1_NodeMetadata(in *rsyslog.NodeMetadata, out *NodeMetadata, s conversion.Scope) error {
+	return autoConvert_rsyslog_NodeMetadata_To_v1alpha1_NodeMetadata(in, out, s)
+}
+
+func autoConvert_v1alpha1_PropertyMatcher_To_rsyslog_PropertyMatcher(in *PropertyMatcher, out *rsyslog.PropertyMatcher, s conversion.Scope) error {
+	out.Property = (*rsyslog.MessageProperty)(unsafe.Pointer(in.Property))
+	out.JournalField = (*string)(unsafe.Pointer(in.JournalField))
+	out.Operator = (*rsyslog.PropertyMatchOperator)(unsafe.Pointer(in.Operator))
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1alpha1_PropertyMatcher_To_rsyslog_PropertyMatcher is an autogenerated conversion function.
+func Convert_v1alpha1_PropertyMatcher_To_rsyslog_PropertyMatcher(in *PropertyMatcher, out *rsyslog.PropertyMatcher, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PropertyMatcher_To_rsyslog_PropertyMatcher(in, out, s)
+}
+
+func autoConvert_rsyslog_PropertyMatcher_To_v1alpha1_PropertyMatcher(in *rsyslog.PropertyMatcher, out *PropertyMatcher, s conversion.Scope) error {
+	out.Property = (*MessageProperty)(unsafe.Pointer(in.Property))
+	out.JournalField = (*string)(unsafe.Pointer(in.JournalField))
+	out.Operator = (*PropertyMatchOperator)(unsafe.Pointer(in.Operator))
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_rsyslog_PropertyMatcher_To_v1alpha1_PropertyMatcher is an autogenerated conversion function.
+func Convert_rsyslog_PropertyMatcher_To_v1alpha1_PropertyMatcher(in *rsyslog.PropertyMatcher, out *PropertyMatcher, s conversion.Scope) error {
+	return autoConvert_rsyslog_PropertyMatcher_To_v1alpha1_PropertyMatcher(in, out, s)
+}
+
+func autoConvert_v1alpha1_Queue_To_rsyslog_Queue(in *Queue, out *rsyslog.Queue, s conversion.Scope) error {
+	out.Size = (*int)(unsafe.Pointer(in.Size))
+	out.MaxDiskSpace = (*string)(unsafe.Pointer(in.MaxDiskSpace))
+	out.HighWatermark = (*int)(unsafe.Pointer(in.HighWatermark))
+	out.LowWatermark = (*int)(unsafe.Pointer(in.LowWatermark))
+	out.DiscardMark = (*int)(unsafe.Pointer(in.DiscardMark))
+	out.DiscardSeverity = (*int)(unsafe.Pointer(in.DiscardSeverity))
+	out.DequeueBatchSize = (*int)(unsafe.Pointer(in.DequeueBatchSize))
+	out.WorkerThreads = (*int)(unsafe.Pointer(in.WorkerThreads))
+	return nil
+}
+
+// Convert_v1alpha1_Queue_To_rsyslog_Queue is an autogenerated conversion function.
+func Convert_v1alpha1_Queue_To_rsyslog_Queue(in *Queue, out *rsyslog.Queue, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Queue_To_rsyslog_Queue(in, out, s)
+}
+
+func autoConvert_rsyslog_Queue_To_v1alpha1_Queue(in *rsyslog.Queue, out *Queue, s conversion.Scope) error {
+	out.Size = (*int)(unsafe.Pointer(in.Size))
+	out.MaxDiskSpace = (*string)(unsafe.Pointer(in.MaxDiskSpace))
+	out.HighWatermark = (*int)(unsafe.Pointer(in.HighWatermark))
+	out.LowWatermark = (*int)(unsafe.Pointer(in.LowWatermark))
+	out.DiscardMark = (*int)(unsafe.Pointer(in.DiscardMark))
+	out.DiscardSeverity = (*int)(unsafe.Pointer(in.DiscardSeverity))
+	out.DequeueBatchSize = (*int)(unsafe.Pointer(in.DequeueBatchSize))
+	out.WorkerThreads = (*int)(unsafe.Pointer(in.WorkerThreads))
+	return nil
+}
+
+// Convert_rsyslog_Queue_To_v1alpha1_Queue is an autogenerated conversion function.
+func Convert_rsyslog_Queue_To_v1alpha1_Queue(in *rsyslog.Queue, out *Queue, s conversion.Scope) error {
+	return autoConvert_rsyslog_Queue_To_v1alpha1_Queue(in, out, s)
+}
+
+func autoConvert_v1alpha1_RateLimit_To_rsyslog_RateLimit(in *RateLimit, out *rsyslog.RateLimit, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_v1alpha1_RateLimit_To_rsyslog_RateLimit is an autogenerated conversion function.
+func Convert_v1alpha1_RateLimit_To_rsyslog_RateLimit(in *RateLimit, out *rsyslog.RateLimit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RateLimit_To_rsyslog_RateLimit(in, out, s)
+}
+
+func autoConvert_rsyslog_RateLimit_To_v1alpha1_RateLimit(in *rsyslog.RateLimit, out *RateLimit, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_rsyslog_RateLimit_To_v1alpha1_RateLimit is an autogenerated conversion function.
+func Convert_rsyslog_RateLimit_To_v1alpha1_RateLimit(in *rsyslog.RateLimit, out *RateLimit, s conversion.Scope) error {
+	return autoConvert_rsyslog_RateLimit_To_v1alpha1_RateLimit(in, out, s)
+}
+
+func autoConvert_v1alpha1_RedactionRule_To_rsyslog_RedactionRule(in *RedactionRule, out *rsyslog.RedactionRule, s conversion.Scope) error {
+	out.Preset = (*rsyslog.RedactionPreset)(unsafe.Pointer(in.Preset))
+	out.Pattern = (*string)(unsafe.Pointer(in.Pattern))
+	out.Replacement = (*string)(unsafe.Pointer(in.Replacement))
+	return nil
+}
+
+// Convert_v1alpha1_RedactionRule_To_rsyslog_RedactionRule is an autogenerated conversion function.
+func Convert_v1alpha1_RedactionRule_To_rsyslog_RedactionRule(in *RedactionRule, out *rsyslog.RedactionRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RedactionRule_To_rsyslog_RedactionRule(in, out, s)
+}
+
+func autoConvert_rsyslog_RedactionRule_To_v1alpha1_RedactionRule(in *rsyslog.RedactionRule, out *RedactionRule, s conversion.Scope) error {
+	out.Preset = (*RedactionPreset)(unsafe.Pointer(in.Preset))
+	out.Pattern = (*string)(unsafe.Pointer(in.Pattern))
+	out.Replacement = (*string)(unsafe.Pointer(in.Replacement))
+	return nil
+}
+
+// Convert_rsyslog_RedactionRule_To_v1alpha1_RedactionRule is an autogenerated conversion function.
+func Convert_rsyslog_RedactionRule_To_v1alpha1_RedactionRule(in *rsyslog.RedactionRule, out *RedactionRule, s conversion.Scope) error {
+	return autoConvert_rsyslog_RedactionRule_To_v1alpha1_RedactionRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_RelpTarget_To_rsyslog_RelpTarget(in *RelpTarget, out *rsyslog.RelpTarget, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Target = in.Target
+	out.Port = in.Port
+	out.Protocol = (*rsyslog.Protocol)(unsafe.Pointer(in.Protocol))
+	out.HTTP = (*rsyslog.HTTP)(unsafe.Pointer(in.HTTP))
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]rsyslog.LoggingRule, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_LoggingRule_To_rsyslog_LoggingRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LoggingRules = nil
+	}
+	out.TLS = (*rsyslog.TLS)(unsafe.Pointer(in.TLS))
+	out.RebindInterval = (*int)(unsafe.Pointer(in.RebindInterval))
+	out.Timeout = (*int)(unsafe.Pointer(in.Timeout))
+	out.ResumeRetryCount = (*int)(unsafe.Pointer(in.ResumeRetryCount))
+	out.ReportSuspensionContinuation = (*bool)(unsafe.Pointer(in.ReportSuspensionContinuation))
+	return nil
+}
+
+// Convert_v1alpha1_RelpTarget_To_rsyslog_RelpTarget is an autogenerated conversion function.
+func Convert_v1alpha1_RelpTarget_To_rsyslog_RelpTarget(in *RelpTarget, out *rsyslog.RelpTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RelpTarget_To_rsyslog_RelpTarget(in, out, s)
+}
+
+func autoConvert_rsyslog_RelpTarget_To_v1alpha1_RelpTarget(in *rsyslog.RelpTarget, out *RelpTarget, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Target = in.Target
+	out.Port = in.Port
+	out.Protocol = (*Protocol)(unsafe.Pointer(in.Protocol))
+	out.HTTP = (*HTTP)(unsafe.Pointer(in.HTTP))
+	out.TLS = (*TLS)(unsafe.Pointer(in.TLS))
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]LoggingRule, len(*in))
+		for i := range *in {
+			if err := Convert_rsyslog_LoggingRule_To_v1alpha1_LoggingRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LoggingRules = nil
+	}
+	out.RebindInterval = (*int)(unsafe.Pointer(in.RebindInterval))
+	out.Timeout = (*int)(unsafe.Pointer(in.Timeout))
+	out.ResumeRetryCount = (*int)(unsafe.Pointer(in.ResumeRetryCount))
+	out.ReportSuspensionContinuation = (*bool)(unsafe.Pointer(in.ReportSuspensionContinuation))
+	return nil
+}
+
+// Convert_rsyslog_RelpTarget_To_v1alpha1_RelpTarget is an autogenerated conversion function.
+func Convert_rsyslog_RelpTarget_To_v1alpha1_RelpTarget(in *rsyslog.RelpTarget, out *RelpTarget, s conversion.Scope) error {
+	return autoConvert_rsyslog_RelpTarget_To_v1alpha1_RelpTarget(in, out, s)
+}
+
 func autoConvert_v1alpha1_RsyslogRelpConfig_To_rsyslog_RsyslogRelpConfig(in *RsyslogRelpConfig, out *rsyslog.RsyslogRelpConfig, s conversion.Scope) error {
 	out.Target = in.Target
 	out.Port = in.Port
-	out.LoggingRules = *(*[]rsyslog.LoggingRule)(unsafe.Pointer(&in.LoggingRules))
+	out.Protocol = (*rsyslog.Protocol)(unsafe.Pointer(in.Protocol))
+	out.HTTP = (*rsyslog.HTTP)(unsafe.Pointer(in.HTTP))
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]rsyslog.LoggingRule, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_LoggingRule_To_rsyslog_LoggingRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LoggingRules = nil
+	}
+	if in.ExcludeRules != nil {
+		in, out := &in.ExcludeRules, &out.ExcludeRules
+		*out = make([]rsyslog.LoggingRule, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_LoggingRule_To_rsyslog_LoggingRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExcludeRules = nil
+	}
 	out.TLS = (*rsyslog.TLS)(unsafe.Pointer(in.TLS))
 	out.RebindInterval = (*int)(unsafe.Pointer(in.RebindInterval))
 	out.Timeout = (*int)(unsafe.Pointer(in.Timeout))
 	out.ResumeRetryCount = (*int)(unsafe.Pointer(in.ResumeRetryCount))
 	out.ReportSuspensionContinuation = (*bool)(unsafe.Pointer(in.ReportSuspensionContinuation))
 	out.AuditConfig = (*rsyslog.AuditConfig)(unsafe.Pointer(in.AuditConfig))
+	if in.AdditionalTargets != nil {
+		in, out := &in.AdditionalTargets, &out.AdditionalTargets
+		*out = make([]rsyslog.RelpTarget, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_RelpTarget_To_rsyslog_RelpTarget(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalTargets = nil
+	}
+	out.FailoverTarget = (*rsyslog.FailoverTarget)(unsafe.Pointer(in.FailoverTarget))
+	out.Queue = (*rsyslog.Queue)(unsafe.Pointer(in.Queue))
+	out.OutputFormat = (*rsyslog.OutputFormat)(unsafe.Pointer(in.OutputFormat))
+	out.StaticMetadata = *(*map[string]string)(unsafe.Pointer(&in.StaticMetadata))
+	out.NodeMetadata = (*rsyslog.NodeMetadata)(unsafe.Pointer(in.NodeMetadata))
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]rsyslog.WorkerPool, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_WorkerPool_To_rsyslog_WorkerPool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WorkerPools = nil
+	}
+	out.Input = (*rsyslog.Input)(unsafe.Pointer(in.Input))
+	out.ContainerLogs = (*rsyslog.ContainerLogs)(unsafe.Pointer(in.ContainerLogs))
+	if in.KernelLogs != nil {
+		in, out := &in.KernelLogs, &out.KernelLogs
+		*out = new(rsyslog.KernelLogs)
+		if err := Convert_v1alpha1_KernelLogs_To_rsyslog_KernelLogs(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KernelLogs = nil
+	}
+	out.Vali = (*rsyslog.Vali)(unsafe.Pointer(in.Vali))
+	out.LocalCopy = (*rsyslog.LocalCopy)(unsafe.Pointer(in.LocalCopy))
+	out.Redaction = *(*[]rsyslog.RedactionRule)(unsafe.Pointer(&in.Redaction))
+	out.AuditStream = (*rsyslog.AuditStream)(unsafe.Pointer(in.AuditStream))
 	return nil
 }
 
@@ -195,13 +1268,78 @@ func Convert_v1alpha1_RsyslogRelpConfig_To_rsyslog_RsyslogRelpConfig(in *Rsyslog
 func autoConvert_rsyslog_RsyslogRelpConfig_To_v1alpha1_RsyslogRelpConfig(in *rsyslog.RsyslogRelpConfig, out *RsyslogRelpConfig, s conversion.Scope) error {
 	out.Target = in.Target
 	out.Port = in.Port
+	out.Protocol = (*Protocol)(unsafe.Pointer(in.Protocol))
+	out.HTTP = (*HTTP)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*TLS)(unsafe.Pointer(in.TLS))
-	out.LoggingRules = *(*[]LoggingRule)(unsafe.Pointer(&in.LoggingRules))
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]LoggingRule, len(*in))
+		for i := range *in {
+			if err := Convert_rsyslog_LoggingRule_To_v1alpha1_LoggingRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LoggingRules = nil
+	}
+	if in.ExcludeRules != nil {
+		in, out := &in.ExcludeRules, &out.ExcludeRules
+		*out = make([]LoggingRule, len(*in))
+		for i := range *in {
+			if err := Convert_rsyslog_LoggingRule_To_v1alpha1_LoggingRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExcludeRules = nil
+	}
 	out.RebindInterval = (*int)(unsafe.Pointer(in.RebindInterval))
 	out.Timeout = (*int)(unsafe.Pointer(in.Timeout))
 	out.ResumeRetryCount = (*int)(unsafe.Pointer(in.ResumeRetryCount))
 	out.ReportSuspensionContinuation = (*bool)(unsafe.Pointer(in.ReportSuspensionContinuation))
 	out.AuditConfig = (*AuditConfig)(unsafe.Pointer(in.AuditConfig))
+	if in.AdditionalTargets != nil {
+		in, out := &in.AdditionalTargets, &out.AdditionalTargets
+		*out = make([]RelpTarget, len(*in))
+		for i := range *in {
+			if err := Convert_rsyslog_RelpTarget_To_v1alpha1_RelpTarget(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalTargets = nil
+	}
+	out.FailoverTarget = (*FailoverTarget)(unsafe.Pointer(in.FailoverTarget))
+	out.Queue = (*Queue)(unsafe.Pointer(in.Queue))
+	out.OutputFormat = (*OutputFormat)(unsafe.Pointer(in.OutputFormat))
+	out.StaticMetadata = *(*map[string]string)(unsafe.Pointer(&in.StaticMetadata))
+	out.NodeMetadata = (*NodeMetadata)(unsafe.Pointer(in.NodeMetadata))
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPool, len(*in))
+		for i := range *in {
+			if err := Convert_rsyslog_WorkerPool_To_v1alpha1_WorkerPool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.WorkerPools = nil
+	}
+	out.Input = (*Input)(unsafe.Pointer(in.Input))
+	out.ContainerLogs = (*ContainerLogs)(unsafe.Pointer(in.ContainerLogs))
+	if in.KernelLogs != nil {
+		in, out := &in.KernelLogs, &out.KernelLogs
+		*out = new(KernelLogs)
+		if err := Convert_rsyslog_KernelLogs_To_v1alpha1_KernelLogs(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KernelLogs = nil
+	}
+	out.Vali = (*Vali)(unsafe.Pointer(in.Vali))
+	out.LocalCopy = (*LocalCopy)(unsafe.Pointer(in.LocalCopy))
+	out.Redaction = *(*[]RedactionRule)(unsafe.Pointer(&in.Redaction))
+	out.AuditStream = (*AuditStream)(unsafe.Pointer(in.AuditStream))
 	return nil
 }
 
@@ -210,6 +1348,56 @@ func Convert_rsyslog_RsyslogRelpConfig_To_v1alpha1_RsyslogRelpConfig(in *rsyslog
 	return autoConvert_rsyslog_RsyslogRelpConfig_To_v1alpha1_RsyslogRelpConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_Sampling_To_rsyslog_Sampling(in *Sampling, out *rsyslog.Sampling, s conversion.Scope) error {
+	out.OneIn = in.OneIn
+	return nil
+}
+
+// Convert_v1alpha1_Sampling_To_rsyslog_Sampling is an autogenerated conversion function.
+func Convert_v1alpha1_Sampling_To_rsyslog_Sampling(in *Sampling, out *rsyslog.Sampling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Sampling_To_rsyslog_Sampling(in, out, s)
+}
+
+func autoConvert_rsyslog_Sampling_To_v1alpha1_Sampling(in *rsyslog.Sampling, out *Sampling, s conversion.Scope) error {
+	out.OneIn = in.OneIn
+	return nil
+}
+
+// Convert_rsyslog_Sampling_To_v1alpha1_Sampling is an autogenerated conversion function.
+func Convert_rsyslog_Sampling_To_v1alpha1_Sampling(in *rsyslog.Sampling, out *Sampling, s conversion.Scope) error {
+	return autoConvert_rsyslog_Sampling_To_v1alpha1_Sampling(in, out, s)
+}
+
+func autoConvert_v1alpha1_SeverityRange_To_rsyslog_SeverityRange(in *SeverityRange, out *rsyslog.SeverityRange, s conversion.Scope) error {
+	if err := Convert_Pointer_intstr_IntOrString_To_Pointer_int(&in.Min, &out.Min, s); err != nil {
+		return err
+	}
+	if err := Convert_Pointer_intstr_IntOrString_To_Pointer_int(&in.Max, &out.Max, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_SeverityRange_To_rsyslog_SeverityRange is an autogenerated conversion function.
+func Convert_v1alpha1_SeverityRange_To_rsyslog_SeverityRange(in *SeverityRange, out *rsyslog.SeverityRange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeverityRange_To_rsyslog_SeverityRange(in, out, s)
+}
+
+func autoConvert_rsyslog_SeverityRange_To_v1alpha1_SeverityRange(in *rsyslog.SeverityRange, out *SeverityRange, s conversion.Scope) error {
+	if err := Convert_Pointer_int_To_Pointer_intstr_IntOrString(&in.Min, &out.Min, s); err != nil {
+		return err
+	}
+	if err := Convert_Pointer_int_To_Pointer_intstr_IntOrString(&in.Max, &out.Max, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_rsyslog_SeverityRange_To_v1alpha1_SeverityRange is an autogenerated conversion function.
+func Convert_rsyslog_SeverityRange_To_v1alpha1_SeverityRange(in *rsyslog.SeverityRange, out *SeverityRange, s conversion.Scope) error {
+	return autoConvert_rsyslog_SeverityRange_To_v1alpha1_SeverityRange(in, out, s)
+}
+
 func autoConvert_v1alpha1_TLS_To_rsyslog_TLS(in *TLS, out *rsyslog.TLS, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.SecretReferenceName = (*string)(unsafe.Pointer(in.SecretReferenceName))
@@ -237,3 +1425,103 @@ func autoConvert_rsyslog_TLS_To_v1alpha1_TLS(in *rsyslog.TLS, out *TLS, s conver
 func Convert_rsyslog_TLS_To_v1alpha1_TLS(in *rsyslog.TLS, out *TLS, s conversion.Scope) error {
 	return autoConvert_rsyslog_TLS_To_v1alpha1_TLS(in, out, s)
 }
+
+func autoConvert_v1alpha1_Transformation_To_rsyslog_Transformation(in *Transformation, out *rsyslog.Transformation, s conversion.Scope) error {
+	if err := Convert_Pointer_intstr_IntOrString_To_Pointer_int(&in.Severity, &out.Severity, s); err != nil {
+		return err
+	}
+	out.SyslogTag = (*string)(unsafe.Pointer(in.SyslogTag))
+	out.AppName = (*string)(unsafe.Pointer(in.AppName))
+	out.Field = (*rsyslog.CustomField)(unsafe.Pointer(in.Field))
+	return nil
+}
+
+// Convert_v1alpha1_Transformation_To_rsyslog_Transformation is an autogenerated conversion function.
+func Convert_v1alpha1_Transformation_To_rsyslog_Transformation(in *Transformation, out *rsyslog.Transformation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Transformation_To_rsyslog_Transformation(in, out, s)
+}
+
+func autoConvert_rsyslog_Transformation_To_v1alpha1_Transformation(in *rsyslog.Transformation, out *Transformation, s conversion.Scope) error {
+	if err := Convert_Pointer_int_To_Pointer_intstr_IntOrString(&in.Severity, &out.Severity, s); err != nil {
+		return err
+	}
+	out.SyslogTag = (*string)(unsafe.Pointer(in.SyslogTag))
+	out.AppName = (*string)(unsafe.Pointer(in.AppName))
+	out.Field = (*CustomField)(unsafe.Pointer(in.Field))
+	return nil
+}
+
+// Convert_rsyslog_Transformation_To_v1alpha1_Transformation is an autogenerated conversion function.
+func Convert_rsyslog_Transformation_To_v1alpha1_Transformation(in *rsyslog.Transformation, out *Transformation, s conversion.Scope) error {
+	return autoConvert_rsyslog_Transformation_To_v1alpha1_Transformation(in, out, s)
+}
+
+func autoConvert_v1alpha1_Vali_To_rsyslog_Vali(in *Vali, out *rsyslog.Vali, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_Vali_To_rsyslog_Vali is an autogenerated conversion function.
+func Convert_v1alpha1_Vali_To_rsyslog_Vali(in *Vali, out *rsyslog.Vali, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Vali_To_rsyslog_Vali(in, out, s)
+}
+
+func autoConvert_rsyslog_Vali_To_v1alpha1_Vali(in *rsyslog.Vali, out *Vali, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_rsyslog_Vali_To_v1alpha1_Vali is an autogenerated conversion function.
+func Convert_rsyslog_Vali_To_v1alpha1_Vali(in *rsyslog.Vali, out *Vali, s conversion.Scope) error {
+	return autoConvert_rsyslog_Vali_To_v1alpha1_Vali(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerPool_To_rsyslog_WorkerPool(in *WorkerPool, out *rsyslog.WorkerPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Target = (*string)(unsafe.Pointer(in.Target))
+	out.Port = (*int)(unsafe.Pointer(in.Port))
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]rsyslog.LoggingRule, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_LoggingRule_To_rsyslog_LoggingRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LoggingRules = nil
+	}
+	out.AuditConfig = (*rsyslog.AuditConfig)(unsafe.Pointer(in.AuditConfig))
+	out.Queue = (*rsyslog.Queue)(unsafe.Pointer(in.Queue))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerPool_To_rsyslog_WorkerPool is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerPool_To_rsyslog_WorkerPool(in *WorkerPool, out *rsyslog.WorkerPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerPool_To_rsyslog_WorkerPool(in, out, s)
+}
+
+func autoConvert_rsyslog_WorkerPool_To_v1alpha1_WorkerPool(in *rsyslog.WorkerPool, out *WorkerPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Target = (*string)(unsafe.Pointer(in.Target))
+	out.Port = (*int)(unsafe.Pointer(in.Port))
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]LoggingRule, len(*in))
+		for i := range *in {
+			if err := Convert_rsyslog_LoggingRule_To_v1alpha1_LoggingRule(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LoggingRules = nil
+	}
+	out.AuditConfig = (*AuditConfig)(unsafe.Pointer(in.AuditConfig))
+	out.Queue = (*Queue)(unsafe.Pointer(in.Queue))
+	return nil
+}
+
+// Convert_rsyslog_WorkerPool_To_v1alpha1_WorkerPool is an autogenerated conversion function.
+func Convert_rsyslog_WorkerPool_To_v1alpha1_WorkerPool(in *rsyslog.WorkerPool, out *WorkerPool, s conversion.Scope) error {
+	return autoConvert_rsyslog_WorkerPool_To_v1alpha1_WorkerPool(in, out, s)
+}
@@ -22,4 +22,13 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 
 func SetObjectDefaults_RsyslogRelpConfig(in *RsyslogRelpConfig) {
 	SetDefaults_RsyslogRelpConfig(in)
+	if in.Queue != nil {
+		SetDefaults_Queue(in.Queue)
+	}
+	for i := range in.WorkerPools {
+		a := &in.WorkerPools[i]
+		if a.Queue != nil {
+			SetDefaults_Queue(a.Queue)
+		}
+	}
 }
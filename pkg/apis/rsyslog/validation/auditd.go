@@ -5,6 +5,11 @@
 package validation
 
 import (
+	"path"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog"
@@ -20,3 +25,216 @@ func ValidateAuditd(auditd *rsyslog.Auditd) field.ErrorList {
 
 	return allErrs
 }
+
+// auditPathRegex matches absolute paths which can be passed to auditctl without quoting.
+var auditPathRegex = regexp.MustCompile(`^/[a-zA-Z0-9._/@+:-]*$`)
+var auditPermissionsRegex = regexp.MustCompile(`^[rwxa]{1,4}$`)
+var auditKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,256}$`)
+var auditSyscallRegex = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+var auditNumberRegex = regexp.MustCompile(`^-?[0-9]{1,20}$`)
+
+// auditIDRegex matches user and group ids, user and group names and "unset", which is the value of the auid of
+// processes not started by a login.
+var auditIDRegex = regexp.MustCompile(`^(-?[0-9]{1,20}|[a-z_][a-z0-9_-]{0,31})$`)
+var auditMessageTypeRegex = regexp.MustCompile(`^([A-Z][A-Z0-9_]{0,31}|[0-9]{1,5})$`)
+
+// auditFieldKind determines the values which can be compared with a field of the audit records.
+type auditFieldKind int
+
+const (
+	auditFieldNumber auditFieldKind = iota
+	auditFieldID
+	auditFieldPath
+	auditFieldPermissions
+	auditFieldMessageType
+)
+
+// auditSyscallRuleFields are the fields which can be used in the filters of syscall rules.
+var auditSyscallRuleFields = map[string]auditFieldKind{
+	"a0":        auditFieldNumber,
+	"a1":        auditFieldNumber,
+	"a2":        auditFieldNumber,
+	"a3":        auditFieldNumber,
+	"exit":      auditFieldNumber,
+	"success":   auditFieldNumber,
+	"pid":       auditFieldNumber,
+	"ppid":      auditFieldNumber,
+	"sessionid": auditFieldNumber,
+	"auid":      auditFieldID,
+	"uid":       auditFieldID,
+	"euid":      auditFieldID,
+	"suid":      auditFieldID,
+	"fsuid":     auditFieldID,
+	"gid":       auditFieldID,
+	"egid":      auditFieldID,
+	"sgid":      auditFieldID,
+	"fsgid":     auditFieldID,
+	"dir":       auditFieldPath,
+	"path":      auditFieldPath,
+	"exe":       auditFieldPath,
+	"perm":      auditFieldPermissions,
+}
+
+// auditExclusionRuleFields are the fields which can be used in the filters of exclusion rules, as the exclude
+// filter of the audit system only supports these.
+var auditExclusionRuleFields = map[string]auditFieldKind{
+	"msgtype": auditFieldMessageType,
+	"pid":     auditFieldNumber,
+	"uid":     auditFieldID,
+	"gid":     auditFieldID,
+	"auid":    auditFieldID,
+	"exe":     auditFieldPath,
+}
+
+var (
+	availableAuditArchs = sets.New(
+		string(rsyslog.AuditArchB64),
+		string(rsyslog.AuditArchB32),
+	)
+	availableAuditFilterOperators = sets.New(
+		string(rsyslog.AuditFilterOperatorEquals),
+		string(rsyslog.AuditFilterOperatorNotEquals),
+		string(rsyslog.AuditFilterOperatorLessThan),
+		string(rsyslog.AuditFilterOperatorLessThanOrEqual),
+		string(rsyslog.AuditFilterOperatorGreaterThan),
+		string(rsyslog.AuditFilterOperatorGreaterThanOrEqual),
+	)
+	// equalityAuditFilterOperators are the operators which can be used for fields that are not numeric.
+	equalityAuditFilterOperators = sets.New(
+		string(rsyslog.AuditFilterOperatorEquals),
+		string(rsyslog.AuditFilterOperatorNotEquals),
+	)
+)
+
+func validateAuditRules(rules *rsyslog.AuditRules, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rules == nil {
+		return allErrs
+	}
+
+	if len(rules.FileWatches) == 0 && len(rules.SyscallRules) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one file watch or syscall rule must be set"))
+	}
+
+	for index, fileWatch := range rules.FileWatches {
+		idxPath := fldPath.Child("fileWatches").Index(index)
+		allErrs = append(allErrs, validateAuditPath(fileWatch.Path, idxPath.Child("path"))...)
+		allErrs = append(allErrs, validateAuditPermissions(fileWatch.Permissions, idxPath.Child("permissions"))...)
+		allErrs = append(allErrs, validateAuditKey(fileWatch.Key, idxPath.Child("key"))...)
+	}
+
+	for index, syscallRule := range rules.SyscallRules {
+		idxPath := fldPath.Child("syscallRules").Index(index)
+
+		if syscallRule.Arch != nil && !availableAuditArchs.Has(string(*syscallRule.Arch)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("arch"), *syscallRule.Arch, sets.List(availableAuditArchs)))
+		}
+
+		if len(syscallRule.Syscalls) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("syscalls"), "at least one syscall must be set"))
+		}
+		syscalls := sets.New[string]()
+		for syscallIndex, syscall := range syscallRule.Syscalls {
+			syscallPath := idxPath.Child("syscalls").Index(syscallIndex)
+			if !auditSyscallRegex.MatchString(syscall) {
+				allErrs = append(allErrs, field.Invalid(syscallPath, syscall, "syscall must consist of lower case letters, digits or '_' and be at most 32 characters long"))
+			} else if syscalls.Has(syscall) {
+				allErrs = append(allErrs, field.Duplicate(syscallPath, syscall))
+			}
+			syscalls.Insert(syscall)
+		}
+
+		allErrs = append(allErrs, validateAuditFieldFilters(syscallRule.Filters, auditSyscallRuleFields, idxPath.Child("filters"))...)
+		allErrs = append(allErrs, validateAuditKey(syscallRule.Key, idxPath.Child("key"))...)
+	}
+
+	for index, exclusionRule := range rules.ExclusionRules {
+		idxPath := fldPath.Child("exclusionRules").Index(index)
+		if len(exclusionRule.Filters) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("filters"), "at least one filter must be set"))
+		}
+		allErrs = append(allErrs, validateAuditFieldFilters(exclusionRule.Filters, auditExclusionRuleFields, idxPath.Child("filters"))...)
+	}
+
+	return allErrs
+}
+
+func validateAuditFieldFilters(filters []rsyslog.AuditFieldFilter, fields map[string]auditFieldKind, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for index, filter := range filters {
+		idxPath := fldPath.Index(index)
+
+		kind, ok := fields[filter.Field]
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("field"), filter.Field, sets.List(sets.KeySet(fields))))
+			continue
+		}
+
+		if operator := filter.Operator; operator != nil {
+			if !availableAuditFilterOperators.Has(string(*operator)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("operator"), *operator, sets.List(availableAuditFilterOperators)))
+			} else if kind != auditFieldNumber && kind != auditFieldID && !equalityAuditFilterOperators.Has(string(*operator)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("operator"), *operator, sets.List(equalityAuditFilterOperators)))
+			}
+		}
+
+		valuePath := idxPath.Child("value")
+		switch kind {
+		case auditFieldNumber:
+			if !auditNumberRegex.MatchString(filter.Value) {
+				allErrs = append(allErrs, field.Invalid(valuePath, filter.Value, "value must be an integer"))
+			}
+		case auditFieldID:
+			if !auditIDRegex.MatchString(filter.Value) {
+				allErrs = append(allErrs, field.Invalid(valuePath, filter.Value, "value must be an id, a user or group name or \"unset\""))
+			}
+		case auditFieldPath:
+			allErrs = append(allErrs, validateAuditPath(filter.Value, valuePath)...)
+		case auditFieldPermissions:
+			allErrs = append(allErrs, validateAuditPermissions(filter.Value, valuePath)...)
+		case auditFieldMessageType:
+			if !auditMessageTypeRegex.MatchString(filter.Value) {
+				allErrs = append(allErrs, field.Invalid(valuePath, filter.Value, "value must be the name or number of an audit message type"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateAuditPath(auditPath string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !auditPathRegex.MatchString(auditPath) {
+		allErrs = append(allErrs, field.Invalid(fldPath, auditPath, "path must be an absolute path consisting of letters, digits or '.', '_', '/', '@', '+', ':' or '-'"))
+	} else if cleanPath := path.Clean(auditPath); auditPath != cleanPath && (cleanPath == "/" || auditPath != cleanPath+"/") {
+		// A single trailing slash is allowed, as it is commonly used for watching directories.
+		allErrs = append(allErrs, field.Invalid(fldPath, auditPath, "path must be clean, i.e. must not contain '.' or '..' elements or repeated slashes"))
+	}
+
+	return allErrs
+}
+
+func validateAuditPermissions(permissions string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !auditPermissionsRegex.MatchString(permissions) {
+		allErrs = append(allErrs, field.Invalid(fldPath, permissions, "permissions must be a combination of 'r', 'w', 'x' and 'a'"))
+	} else if sets.New(strings.Split(permissions, "")...).Len() != len(permissions) {
+		allErrs = append(allErrs, field.Invalid(fldPath, permissions, "permissions must not contain a permission more than once"))
+	}
+
+	return allErrs
+}
+
+func validateAuditKey(key *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if key != nil && !auditKeyRegex.MatchString(*key) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *key, "key must consist of letters, digits, '_' or '-' and be at most 256 characters long"))
+	}
+
+	return allErrs
+}
@@ -8,17 +8,33 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
+	"unicode"
 
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog"
 )
 
 var printableCharactersRegex = regexp.MustCompile(`^[!-~]*$`)
 var invalidCharactersForProgramNameRegex = regexp.MustCompile(`[[:/]`)
+var onlyWildcardsProgramNameRegex = regexp.MustCompile(`^[*?]+$`)
 var permittedPeerRegex = regexp.MustCompile(`^SHA1:[0-9A-Fa-f]{40}$`)
+var queueDiskSpaceRegex = regexp.MustCompile(`^[1-9][0-9]*[kKmMgG]?$`)
+var metadataKeyRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,31}$`)
+var journalFieldRegex = regexp.MustCompile(`^[A-Z_][A-Z0-9_]{0,31}$`)
+var systemdUnitRegex = regexp.MustCompile(`^[a-zA-Z0-9:_.@-]+$`)
+var intervalRegex = regexp.MustCompile(`^\{[0-9]+(,[0-9]*)?\}`)
+var syslogTagRegex = regexp.MustCompile(`^[!-~]{1,32}$`)
+var appNameRegex = regexp.MustCompile(`^[!-~]{1,48}$`)
+
+// metadataValueRegex matches printable ASCII characters except `"`, `\` and `]`, which would have to be escaped in
+// the rsyslog configuration and in RFC 5424 structured data.
+var metadataValueRegex = regexp.MustCompile(`^[ !#-\[^-~]{0,256}$`)
 
 // ValidateRsyslogRelpConfig validates the passed configuration instance.
 func ValidateRsyslogRelpConfig(config *rsyslog.RsyslogRelpConfig, _ *field.Path) field.ErrorList {
@@ -26,8 +42,253 @@ func ValidateRsyslogRelpConfig(config *rsyslog.RsyslogRelpConfig, _ *field.Path)
 
 	allErrs = append(allErrs, validateTarget(config.Target, field.NewPath("target"))...)
 	allErrs = append(allErrs, validatePort(config.Port, field.NewPath("port"))...)
+	allErrs = append(allErrs, validateProtocol(config.Protocol, config.HTTP, config.TLS, config.RebindInterval, config.Timeout, nil)...)
 	allErrs = append(allErrs, validateTLS(config.TLS, field.NewPath("tls"))...)
 	allErrs = append(allErrs, validateLoggingRules(config.LoggingRules, field.NewPath("loggingRules"))...)
+	allErrs = append(allErrs, validateExcludeRules(config.ExcludeRules, field.NewPath("excludeRules"))...)
+	allErrs = append(allErrs, validateAdditionalTargets(config.AdditionalTargets, field.NewPath("additionalTargets"))...)
+	allErrs = append(allErrs, validateFailoverTarget(config.FailoverTarget, config.ResumeRetryCount, field.NewPath("failoverTarget"))...)
+	allErrs = append(allErrs, validateTLSLibs(config)...)
+	allErrs = append(allErrs, validateQueue(config.Queue, field.NewPath("queue"))...)
+	allErrs = append(allErrs, validateOutputFormat(config.OutputFormat, field.NewPath("outputFormat"))...)
+	allErrs = append(allErrs, validateHTTPOutputFormat(config)...)
+	allErrs = append(allErrs, validateStaticMetadata(config.StaticMetadata, field.NewPath("staticMetadata"))...)
+	allErrs = append(allErrs, validateCustomFieldNames(config)...)
+	allErrs = append(allErrs, validateWorkerPools(config.WorkerPools, field.NewPath("workerPools"))...)
+	allErrs = append(allErrs, validateInput(config)...)
+	allErrs = append(allErrs, validateContainerLogs(config.ContainerLogs, field.NewPath("containerLogs"))...)
+	allErrs = append(allErrs, validateKernelLogs(config.KernelLogs, field.NewPath("kernelLogs"))...)
+	allErrs = append(allErrs, validateLocalCopy(config.LocalCopy, field.NewPath("localCopy"))...)
+	allErrs = append(allErrs, validateRedaction(config.Redaction, field.NewPath("redaction"))...)
+	allErrs = append(allErrs, validateAuditStream(config.AuditStream, field.NewPath("auditStream"))...)
+	allErrs = append(allErrs, validateAuditConfig(config.AuditConfig, field.NewPath("auditConfig"))...)
+
+	return allErrs
+}
+
+// reservedTargetNames contains names which are used for the rsyslog relp actions and TLS directories of
+// targets that are not configured via the additionalTargets field, i.e. the failover target and the audit stream, for
+// the actions pushing log messages to Vali, writing the local copy and redacting log messages, and for the directory
+// of the token with which the metadata of the pods is read.
+var reservedTargetNames = sets.New(
+	"failover",
+	"audit",
+	"vali",
+	"local-copy",
+	"redaction",
+	"kubernetes",
+)
+
+func validateAdditionalTargets(additionalTargets []rsyslog.RelpTarget, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for index, additionalTarget := range additionalTargets {
+		idxPath := fldPath.Index(index)
+
+		if additionalTarget.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must not be empty"))
+		} else {
+			for _, err := range validation.IsDNS1123Label(additionalTarget.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), additionalTarget.Name, err))
+			}
+			if names.Has(additionalTarget.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), additionalTarget.Name))
+			}
+			if reservedTargetNames.Has(additionalTarget.Name) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), fmt.Sprintf("name %q is reserved", additionalTarget.Name)))
+			}
+			names.Insert(additionalTarget.Name)
+		}
+
+		allErrs = append(allErrs, validateTarget(additionalTarget.Target, idxPath.Child("target"))...)
+		allErrs = append(allErrs, validatePort(additionalTarget.Port, idxPath.Child("port"))...)
+		allErrs = append(allErrs, validateProtocol(additionalTarget.Protocol, additionalTarget.HTTP, additionalTarget.TLS, additionalTarget.RebindInterval, additionalTarget.Timeout, idxPath)...)
+		allErrs = append(allErrs, validateTLS(additionalTarget.TLS, idxPath.Child("tls"))...)
+		allErrs = append(allErrs, validateLoggingRules(additionalTarget.LoggingRules, idxPath.Child("loggingRules"))...)
+	}
+
+	return allErrs
+}
+
+func validateWorkerPools(workerPools []rsyslog.WorkerPool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for index, workerPool := range workerPools {
+		idxPath := fldPath.Index(index)
+
+		if workerPool.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must not be empty"))
+		} else {
+			for _, err := range validation.IsDNS1123Label(workerPool.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), workerPool.Name, err))
+			}
+			if names.Has(workerPool.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), workerPool.Name))
+			}
+			names.Insert(workerPool.Name)
+		}
+
+		if workerPool.Target != nil {
+			allErrs = append(allErrs, validateTarget(*workerPool.Target, idxPath.Child("target"))...)
+		}
+		if workerPool.Port != nil {
+			allErrs = append(allErrs, validatePort(*workerPool.Port, idxPath.Child("port"))...)
+		}
+		if workerPool.LoggingRules != nil {
+			allErrs = append(allErrs, validateLoggingRules(workerPool.LoggingRules, idxPath.Child("loggingRules"))...)
+		}
+		allErrs = append(allErrs, validateQueue(workerPool.Queue, idxPath.Child("queue"))...)
+		allErrs = append(allErrs, validateAuditConfig(workerPool.AuditConfig, idxPath.Child("auditConfig"))...)
+	}
+
+	return allErrs
+}
+
+func validateFailoverTarget(failoverTarget *rsyslog.FailoverTarget, resumeRetryCount *int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if failoverTarget == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateTarget(failoverTarget.Target, fldPath.Child("target"))...)
+	allErrs = append(allErrs, validatePort(failoverTarget.Port, fldPath.Child("port"))...)
+	allErrs = append(allErrs, validateProtocol(failoverTarget.Protocol, failoverTarget.HTTP, failoverTarget.TLS, failoverTarget.RebindInterval, failoverTarget.Timeout, fldPath)...)
+	allErrs = append(allErrs, validateTLS(failoverTarget.TLS, fldPath.Child("tls"))...)
+
+	// An rsyslog relp action which retries endlessly is never considered suspended, so the failover target would never be used.
+	if resumeRetryCount != nil && *resumeRetryCount == -1 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("resumeRetryCount"), *resumeRetryCount, "resumeRetryCount must not be -1 when a failover target is configured"))
+	}
+
+	return allErrs
+}
+
+// validateTLSLibs validates that all targets which specify a tls library use the same one, as the
+// tls library is configured globally for the omrelp module.
+func validateTLSLibs(config *rsyslog.RsyslogRelpConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var tlsLib *rsyslog.TLSLib
+	checkTLSLib := func(tls *rsyslog.TLS, fldPath *field.Path) {
+		if tls == nil || tls.TLSLib == nil {
+			return
+		}
+		if tlsLib == nil {
+			tlsLib = tls.TLSLib
+		} else if *tlsLib != *tls.TLSLib {
+			allErrs = append(allErrs, field.Invalid(fldPath, *tls.TLSLib, fmt.Sprintf("all targets must use the same tls library, %q is already configured", *tlsLib)))
+		}
+	}
+
+	checkTLSLib(config.TLS, field.NewPath("tls", "tlsLib"))
+	if config.FailoverTarget != nil {
+		checkTLSLib(config.FailoverTarget.TLS, field.NewPath("failoverTarget", "tls", "tlsLib"))
+	}
+	for index, additionalTarget := range config.AdditionalTargets {
+		checkTLSLib(additionalTarget.TLS, field.NewPath("additionalTargets").Index(index).Child("tls", "tlsLib"))
+	}
+
+	return allErrs
+}
+
+func validateQueue(queue *rsyslog.Queue, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if queue == nil {
+		return allErrs
+	}
+
+	if queue.MaxDiskSpace != nil && !queueDiskSpaceRegex.MatchString(*queue.MaxDiskSpace) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxDiskSpace"), *queue.MaxDiskSpace, "maxDiskSpace must be a positive number of bytes with an optional k, m or g suffix"))
+	}
+
+	for _, option := range []struct {
+		name  string
+		value *int
+	}{
+		{"size", queue.Size},
+		{"highWatermark", queue.HighWatermark},
+		{"lowWatermark", queue.LowWatermark},
+		{"discardMark", queue.DiscardMark},
+		{"dequeueBatchSize", queue.DequeueBatchSize},
+		{"workerThreads", queue.WorkerThreads},
+	} {
+		if option.value != nil && *option.value < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(option.name), *option.value, fmt.Sprintf("%s must be greater than 0", option.name)))
+		}
+	}
+
+	if queue.Size != nil {
+		for _, mark := range []struct {
+			name  string
+			value *int
+		}{
+			{"highWatermark", queue.HighWatermark},
+			{"lowWatermark", queue.LowWatermark},
+			{"discardMark", queue.DiscardMark},
+		} {
+			if mark.value != nil && *mark.value > *queue.Size {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child(mark.name), *mark.value, fmt.Sprintf("%s must not be greater than size", mark.name)))
+			}
+		}
+	}
+
+	if queue.HighWatermark != nil && queue.LowWatermark != nil && *queue.LowWatermark >= *queue.HighWatermark {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("lowWatermark"), *queue.LowWatermark, "lowWatermark must be less than highWatermark"))
+	}
+
+	if queue.HighWatermark != nil && queue.DiscardMark != nil && *queue.DiscardMark < *queue.HighWatermark {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("discardMark"), *queue.DiscardMark, "discardMark must not be less than highWatermark"))
+	}
+
+	if queue.DiscardSeverity != nil && (*queue.DiscardSeverity < 0 || *queue.DiscardSeverity > 7) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("discardSeverity"), *queue.DiscardSeverity, "discardSeverity must be between 0 and 7"))
+	}
+
+	return allErrs
+}
+
+// reservedMetadataKeys contains the keys of the metadata and syslog properties which are added to the log messages
+// sent to the target servers.
+var reservedMetadataKeys = sets.New(
+	"projectName",
+	"shootName",
+	"shootUID",
+	"workerPool",
+	"machineType",
+	"zones",
+	"region",
+	"seedName",
+	"kubernetesVersion",
+	"namespace",
+	"pod",
+	"container",
+	"labels",
+	"hostname",
+	"pri",
+	"syslogtag",
+	"timestamp",
+	"procid",
+	"msgid",
+	"msg",
+)
+
+func validateStaticMetadata(staticMetadata map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for key, value := range staticMetadata {
+		if !metadataKeyRegex.MatchString(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath, key, "keys must start with a letter, contain only letters, digits or `_` and be at most 32 characters long"))
+		} else if reservedMetadataKeys.Has(key) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), fmt.Sprintf("key %q is reserved", key)))
+		}
+		if !metadataValueRegex.MatchString(value) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(key), value, "values can only contain printable characters except `\"`, `\\` and `]` and be at most 256 characters long"))
+		}
+	}
 
 	return allErrs
 }
@@ -68,8 +329,459 @@ var (
 		string(rsyslog.TLSLibOpenSSL),
 		string(rsyslog.TLSLibGnuTLS),
 	)
+	availableProtocols = sets.New(
+		string(rsyslog.ProtocolRELP),
+		string(rsyslog.ProtocolTCP),
+		string(rsyslog.ProtocolHTTP),
+		string(rsyslog.ProtocolOTLP),
+	)
+	availableHTTPBatchFormats = sets.New(
+		string(rsyslog.HTTPBatchFormatNewline),
+		string(rsyslog.HTTPBatchFormatJSONArray),
+	)
+	availableFacilities = sets.New(
+		"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news", "uucp", "cron", "authpriv", "ftp",
+		"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+	)
+	availableMessageProperties = sets.New(
+		string(rsyslog.MessagePropertyFacility),
+		string(rsyslog.MessagePropertyHostname),
+		string(rsyslog.MessagePropertySyslogTag),
+		string(rsyslog.MessagePropertyAppName),
+		string(rsyslog.MessagePropertyProcID),
+		string(rsyslog.MessagePropertyProgramName),
+		string(rsyslog.MessagePropertyMessage),
+	)
+	availablePropertyMatchOperators = sets.New(
+		string(rsyslog.PropertyMatchOperatorEquals),
+		string(rsyslog.PropertyMatchOperatorStartsWith),
+		string(rsyslog.PropertyMatchOperatorRegex),
+	)
+	availableRedactionPresets = sets.New(
+		string(rsyslog.RedactionPresetJWT),
+		string(rsyslog.RedactionPresetAWSAccessKey),
+		string(rsyslog.RedactionPresetEmail),
+	)
+	availableInputModes = sets.New(
+		string(rsyslog.InputModeImuxsock),
+		string(rsyslog.InputModeImjournal),
+	)
+	availableOutputFormats = sets.New(
+		string(rsyslog.OutputFormatRFC5424),
+		string(rsyslog.OutputFormatRFC3164),
+		string(rsyslog.OutputFormatJSON),
+	)
 )
 
+// validateInput validates the input configuration and that journal fields are only used when rsyslog reads
+// log messages from the journal.
+func validateInput(config *rsyslog.RsyslogRelpConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	fldPath := field.NewPath("input")
+	input := config.Input
+	if input == nil {
+		input = &rsyslog.Input{}
+	}
+
+	if input.Mode != nil && !availableInputModes.Has(string(*input.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), input.Mode, sets.List(availableInputModes)))
+	}
+
+	fields := sets.New[string]()
+	for index, journalField := range input.JournalFields {
+		if !journalFieldRegex.MatchString(journalField) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("journalFields").Index(index), journalField, "journal fields must only contain uppercase letters, digits or `_`, must not start with a digit and be at most 32 characters long"))
+		}
+		if fields.Has(journalField) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("journalFields").Index(index), journalField))
+		}
+		fields.Insert(journalField)
+	}
+
+	if ptr.Deref(input.Mode, "") == rsyslog.InputModeImjournal {
+		return allErrs
+	}
+
+	const detail = "can only be set if the input mode is \"imjournal\""
+	if input.ReplayJournal != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("replayJournal"), detail))
+	}
+	if len(input.JournalFields) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("journalFields"), detail))
+	}
+
+	checkLoggingRules := func(loggingRules []rsyslog.LoggingRule, fldPath *field.Path) {
+		for index, rule := range loggingRules {
+			if len(rule.SystemdUnits) > 0 {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Index(index).Child("systemdUnits"), detail))
+			}
+			for matcherIndex, matcher := range rule.PropertyMatchers {
+				if matcher.JournalField != nil {
+					allErrs = append(allErrs, field.Forbidden(fldPath.Index(index).Child("propertyMatchers").Index(matcherIndex).Child("journalField"), detail))
+				}
+			}
+			walkCondition(rule.Condition, fldPath.Index(index).Child("condition"), func(condition *rsyslog.Condition, fldPath *field.Path) {
+				if condition.Match != nil && condition.Match.JournalField != nil {
+					allErrs = append(allErrs, field.Forbidden(fldPath.Child("match", "journalField"), detail))
+				}
+			})
+		}
+	}
+
+	checkLoggingRules(config.LoggingRules, field.NewPath("loggingRules"))
+	checkLoggingRules(config.ExcludeRules, field.NewPath("excludeRules"))
+	for index, additionalTarget := range config.AdditionalTargets {
+		checkLoggingRules(additionalTarget.LoggingRules, field.NewPath("additionalTargets").Index(index).Child("loggingRules"))
+	}
+	for index, workerPool := range config.WorkerPools {
+		checkLoggingRules(workerPool.LoggingRules, field.NewPath("workerPools").Index(index).Child("loggingRules"))
+	}
+	if config.KernelLogs != nil {
+		checkLoggingRules(config.KernelLogs.LoggingRules, field.NewPath("kernelLogs", "loggingRules"))
+	}
+
+	return allErrs
+}
+
+func validateContainerLogs(containerLogs *rsyslog.ContainerLogs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if containerLogs == nil {
+		return allErrs
+	}
+
+	namespaces := sets.New[string]()
+	for index, namespace := range containerLogs.Namespaces {
+		idxPath := fldPath.Child("namespaces").Index(index)
+		for _, err := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(idxPath, namespace, err))
+		}
+		if namespaces.Has(namespace) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, namespace))
+		}
+		namespaces.Insert(namespace)
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(containerLogs.PodSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("podSelector"))...)
+
+	return allErrs
+}
+
+// validateKernelLogs validates the logging rules for kernel messages. Other than for the target servers, they
+// are optional as all kernel messages are forwarded if none are specified.
+func validateKernelLogs(kernelLogs *rsyslog.KernelLogs, fldPath *field.Path) field.ErrorList {
+	if kernelLogs == nil || len(kernelLogs.LoggingRules) == 0 {
+		return field.ErrorList{}
+	}
+
+	return validateLoggingRules(kernelLogs.LoggingRules, fldPath.Child("loggingRules"))
+}
+
+func validateLocalCopy(localCopy *rsyslog.LocalCopy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if localCopy == nil {
+		return allErrs
+	}
+
+	if localCopy.MaxSize != nil && !queueDiskSpaceRegex.MatchString(*localCopy.MaxSize) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSize"), *localCopy.MaxSize, "maxSize must be a positive number of bytes with an optional k, m or g suffix"))
+	}
+
+	if localCopy.MaxFiles != nil && *localCopy.MaxFiles <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxFiles"), *localCopy.MaxFiles, "maxFiles must be greater than 0"))
+	}
+
+	if localCopy.MaxAge != nil && *localCopy.MaxAge <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAge"), *localCopy.MaxAge, "maxAge must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validateAuditConfig(auditConfig *rsyslog.AuditConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if auditConfig == nil {
+		return allErrs
+	}
+
+	if auditConfig.ConfigMapReferenceName != nil && auditConfig.Rules != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("rules"), "rules cannot be set together with configMapReferenceName"))
+	}
+	allErrs = append(allErrs, validateAuditRules(auditConfig.Rules, fldPath.Child("rules"))...)
+
+	return allErrs
+}
+
+func validateAuditStream(auditStream *rsyslog.AuditStream, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if auditStream == nil {
+		return allErrs
+	}
+
+	if auditStream.Port != nil {
+		allErrs = append(allErrs, validatePort(*auditStream.Port, fldPath.Child("port"))...)
+	}
+
+	if queue := auditStream.Queue; queue != nil {
+		queuePath := fldPath.Child("queue")
+		if queue.Size != nil && *queue.Size < 1 {
+			allErrs = append(allErrs, field.Invalid(queuePath.Child("size"), *queue.Size, "size must be greater than 0"))
+		}
+		// The queue blocks new audit events instead of discarding them once it is full, hence it must not spool an
+		// unlimited number of audit events to the disk of the node.
+		if maxDiskSpace := queue.MaxDiskSpace; maxDiskSpace != nil {
+			switch {
+			case strings.TrimLeft(strings.TrimRight(*maxDiskSpace, "kKmMgG"), "0") == "":
+				allErrs = append(allErrs, field.Invalid(queuePath.Child("maxDiskSpace"), *maxDiskSpace, "maxDiskSpace must be greater than 0, as the disk space of the audit stream queue has to be limited"))
+			case !queueDiskSpaceRegex.MatchString(*maxDiskSpace):
+				allErrs = append(allErrs, field.Invalid(queuePath.Child("maxDiskSpace"), *maxDiskSpace, "maxDiskSpace must be a positive number of bytes with an optional k, m or g suffix"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateRedaction(redaction []rsyslog.RedactionRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for index, rule := range redaction {
+		rulePath := fldPath.Index(index)
+
+		switch {
+		case rule.Preset == nil && rule.Pattern == nil:
+			allErrs = append(allErrs, field.Required(rulePath, "either .preset or .pattern has to be provided"))
+		case rule.Preset != nil && rule.Pattern != nil:
+			allErrs = append(allErrs, field.Forbidden(rulePath, ".preset and .pattern cannot be set together"))
+		}
+
+		if rule.Preset != nil && !availableRedactionPresets.Has(string(*rule.Preset)) {
+			allErrs = append(allErrs, field.NotSupported(rulePath.Child("preset"), *rule.Preset, sets.List(availableRedactionPresets)))
+		}
+
+		if rule.Pattern != nil {
+			patternPath := rulePath.Child("pattern")
+			if regex, err := regexp.CompilePOSIX(*rule.Pattern); err != nil {
+				allErrs = append(allErrs, field.Invalid(patternPath, *rule.Pattern, fmt.Sprintf("not a valid POSIX ERE regular expression: %v", err)))
+			} else if regex.MatchString("") {
+				allErrs = append(allErrs, field.Invalid(patternPath, *rule.Pattern, "pattern must not match the empty string"))
+			} else if detail := unportableRedactionPatternSyntax(*rule.Pattern); detail != "" {
+				allErrs = append(allErrs, field.Invalid(patternPath, *rule.Pattern, detail))
+			}
+		}
+
+		if rule.Replacement != nil && strings.ContainsFunc(*rule.Replacement, unicode.IsControl) {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("replacement"), *rule.Replacement, "replacement must not contain control characters"))
+		}
+	}
+
+	return allErrs
+}
+
+// escapableRedactionPatternCharacters are the characters which can be escaped with a backslash in redaction patterns.
+const escapableRedactionPatternCharacters = `.[]()*+?{}|^$\`
+
+// unportableRedactionPatternSyntax returns why the given redaction pattern uses syntax which is interpreted
+// differently by POSIX ERE, with which rsyslog selects the log messages to redact, and by Oniguruma, with which jq
+// replaces the matches, or an empty string if it does not. The pattern must be a valid POSIX ERE regular expression.
+func unportableRedactionPatternSyntax(pattern string) string {
+	previousIsRepetition := false
+	for i := 0; i < len(pattern); i++ {
+		isRepetition := false
+		switch pattern[i] {
+		case '\\':
+			if i+1 == len(pattern) || !strings.ContainsRune(escapableRedactionPatternCharacters, rune(pattern[i+1])) {
+				return fmt.Sprintf("backslashes may only escape one of the characters %s", escapableRedactionPatternCharacters)
+			}
+			i++
+		case '[':
+			i++
+			if i < len(pattern) && pattern[i] == '^' {
+				i++
+			}
+			if i < len(pattern) && pattern[i] == ']' {
+				i++
+			}
+			for ; i < len(pattern) && pattern[i] != ']'; i++ {
+				switch {
+				case pattern[i] == '\\':
+					return "bracket expressions must not contain backslashes"
+				case strings.HasPrefix(pattern[i:], "[:"):
+					i += strings.Index(pattern[i:], ":]") + 1
+				case pattern[i] == '[' || strings.HasPrefix(pattern[i:], "&&"):
+					return "bracket expressions must not contain '[' except in character classes like [:alpha:] or '&&'"
+				}
+			}
+		case '*', '+', '?':
+			isRepetition = true
+		case '{':
+			interval := intervalRegex.FindString(pattern[i:])
+			if interval == "" {
+				return "braces must either enclose a repetition count like {n}, {n,} or {n,m} or be escaped"
+			}
+			i += len(interval) - 1
+			isRepetition = true
+		}
+		if isRepetition && previousIsRepetition {
+			return "repetition operators must not follow each other"
+		}
+		previousIsRepetition = isRepetition
+	}
+	return ""
+}
+
+func validateOutputFormat(outputFormat *rsyslog.OutputFormat, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if outputFormat != nil && !availableOutputFormats.Has(string(*outputFormat)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, outputFormat, sets.List(availableOutputFormats)))
+	}
+
+	return allErrs
+}
+
+// validateProtocol validates the protocol of a target server and that the options of the rsyslog relp action are
+// only set if the target server is reached via a protocol supporting them. The fldPath is the path of the target server, which is nil for
+// the primary target server.
+func validateProtocol(protocol *rsyslog.Protocol, http *rsyslog.HTTP, tls *rsyslog.TLS, rebindInterval, timeout *int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if protocol != nil && !availableProtocols.Has(string(*protocol)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), protocol, sets.List(availableProtocols)))
+		return allErrs
+	}
+
+	switch ptr.Deref(protocol, rsyslog.ProtocolRELP) {
+	case rsyslog.ProtocolHTTP:
+		allErrs = append(allErrs, validateHTTP(http, fldPath.Child("http"))...)
+	case rsyslog.ProtocolOTLP:
+		allErrs = append(allErrs, validateHTTP(http, fldPath.Child("http"))...)
+		// Every request sent to an OTLP/HTTP endpoint must contain a single export request, i.e. one JSON object with
+		// a resourceLogs array. None of the batch formats of the omhttp module produces it, hence the log records
+		// cannot be combined into batches.
+		if http != nil && http.Batch != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("http", "batch"), "cannot be set if the protocol is \"otlp\""))
+		}
+	default:
+		if http != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("http"), "can only be set if the protocol is \"http\" or \"otlp\""))
+		}
+	}
+
+	if protocol == nil || *protocol == rsyslog.ProtocolRELP {
+		return allErrs
+	}
+
+	const detail = "can only be set if the protocol is \"relp\""
+	if rebindInterval != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("rebindInterval"), detail))
+	}
+	// The omhttp module applies the timeout to its requests.
+	if timeout != nil && *protocol == rsyslog.ProtocolTCP {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("timeout"), "cannot be set if the protocol is \"tcp\""))
+	}
+
+	if tls == nil {
+		return allErrs
+	}
+
+	if *protocol == rsyslog.ProtocolHTTP || *protocol == rsyslog.ProtocolOTLP {
+		// The omhttp module only verifies the certificate of the target server against the certificate authority.
+		detail := fmt.Sprintf("cannot be set if the protocol is %q", *protocol)
+		if tls.AuthMode != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("tls", "authMode"), detail))
+		}
+		if len(tls.PermittedPeer) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("tls", "permittedPeer"), detail))
+		}
+		if tls.TLSLib != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("tls", "tlsLib"), detail))
+		}
+	} else {
+		if ptr.Deref(tls.AuthMode, "") == rsyslog.AuthModeFingerPrint {
+			// The permitted peers only match the format of SHA1 fingerprints expected by librelp.
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("tls", "authMode"), "authMode \"fingerprint\" "+detail))
+		}
+		// The stream driver only authenticates the target server if the connection is secured with TLS.
+		if !tls.Enabled {
+			const detail = "can only be set if tls is enabled when the protocol is \"tcp\""
+			if tls.AuthMode != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("tls", "authMode"), detail))
+			}
+			if len(tls.PermittedPeer) > 0 {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("tls", "permittedPeer"), detail))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// httpPathRegex matches the path of an http ingestion endpoint. The path is relative to the root of the target
+// server and must not contain characters which would need to be escaped in the rsyslog configuration.
+var httpPathRegex = regexp.MustCompile(`^[A-Za-z0-9\-._~!$&'()*+,;=:@%?][A-Za-z0-9\-._~!$&'()*+,;=:@%?/]*$`)
+
+func validateHTTP(http *rsyslog.HTTP, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if http == nil {
+		return allErrs
+	}
+
+	if http.Path != nil && !httpPathRegex.MatchString(*http.Path) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), *http.Path, "path must be a non-empty url path without a leading slash"))
+	}
+
+	if http.TokenSecretReferenceName != nil && *http.TokenSecretReferenceName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("tokenSecretReferenceName"), "tokenSecretReferenceName must not be empty"))
+	}
+
+	if batch := http.Batch; batch != nil {
+		batchPath := fldPath.Child("batch")
+		if batch.Format != nil && !availableHTTPBatchFormats.Has(string(*batch.Format)) {
+			allErrs = append(allErrs, field.NotSupported(batchPath.Child("format"), batch.Format, sets.List(availableHTTPBatchFormats)))
+		}
+		if batch.MaxSize != nil && *batch.MaxSize <= 0 {
+			allErrs = append(allErrs, field.Invalid(batchPath.Child("maxSize"), *batch.MaxSize, "maxSize must be greater than 0"))
+		}
+		if batch.MaxBytes != nil && *batch.MaxBytes <= 0 {
+			allErrs = append(allErrs, field.Invalid(batchPath.Child("maxBytes"), *batch.MaxBytes, "maxBytes must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateHTTPOutputFormat validates that log messages are formatted as JSON if any target server is reached via
+// http, as the ingestion endpoints expect JSON documents.
+func validateHTTPOutputFormat(config *rsyslog.RsyslogRelpConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if ptr.Deref(config.OutputFormat, "") == rsyslog.OutputFormatJSON {
+		return allErrs
+	}
+
+	usesHTTP := ptr.Deref(config.Protocol, "") == rsyslog.ProtocolHTTP
+	if config.FailoverTarget != nil && ptr.Deref(config.FailoverTarget.Protocol, "") == rsyslog.ProtocolHTTP {
+		usesHTTP = true
+	}
+	for _, additionalTarget := range config.AdditionalTargets {
+		if ptr.Deref(additionalTarget.Protocol, "") == rsyslog.ProtocolHTTP {
+			usesHTTP = true
+		}
+	}
+
+	if usesHTTP {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("outputFormat"), ptr.Deref(config.OutputFormat, ""), "outputFormat must be \"json\" if the protocol of a target server is \"http\""))
+	}
+
+	return allErrs
+}
+
 func validateTLS(tls *rsyslog.TLS, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -112,16 +824,52 @@ func validateTLS(tls *rsyslog.TLS, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+func validateExcludeRules(excludeRules []rsyslog.LoggingRule, fldPath *field.Path) field.ErrorList {
+	if len(excludeRules) == 0 {
+		return field.ErrorList{}
+	}
+
+	allErrs := validateLoggingRules(excludeRules, fldPath)
+	for index, rule := range excludeRules {
+		if rule.RateLimit != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(index).Child("rateLimit"), ".rateLimit cannot be set for exclude rules"))
+		}
+		if rule.Sampling != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(index).Child("sampling"), ".sampling cannot be set for exclude rules"))
+		}
+		if len(rule.Transformations) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(index).Child("transformations"), ".transformations cannot be set for exclude rules"))
+		}
+	}
+
+	return allErrs
+}
+
 func validateLoggingRules(loggingRules []rsyslog.LoggingRule, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if len(loggingRules) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath, "at least one logging rule is required"))
 	} else {
 		for index, rule := range loggingRules {
-			if len(rule.ProgramNames) == 0 && rule.Severity == nil && rule.MessageContent == nil {
-				allErrs = append(allErrs, field.Required(fldPath.Index(index), "at least one of .programNames, .messageContent, or .severity is required"))
+			if len(rule.ProgramNames) == 0 && len(rule.SystemdUnits) == 0 && len(rule.Facilities) == 0 && rule.Severity == nil && rule.SeverityRange == nil && rule.MessageContent == nil && len(rule.PropertyMatchers) == 0 && rule.Condition == nil {
+				allErrs = append(allErrs, field.Required(fldPath.Index(index), "at least one of .programNames, .systemdUnits, .facilities, .messageContent, .propertyMatchers, .condition, .severity, or .severityRange is required"))
+			}
+			allErrs = append(allErrs, validateSeverity(rule.Severity, fldPath.Index(index).Child("severity"))...)
+			allErrs = append(allErrs, validateSeverityRange(rule.SeverityRange, fldPath.Index(index).Child("severityRange"))...)
+			if rule.Severity != nil && rule.SeverityRange != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Index(index).Child("severityRange"), ".severity and .severityRange cannot be set at the same time"))
 			}
 			allErrs = append(allErrs, validateProgramNames(rule.ProgramNames, fldPath.Child("programNames"))...)
+			for unitIndex, unit := range rule.SystemdUnits {
+				if !systemdUnitRegex.MatchString(unit) {
+					allErrs = append(allErrs, field.Invalid(fldPath.Index(index).Child("systemdUnits").Index(unitIndex), unit, ".systemdUnits can only contain letters, digits, `:`, `_`, `.`, `@` or `-`"))
+				}
+			}
+			for facilityIndex, facility := range rule.Facilities {
+				if !availableFacilities.Has(facility) {
+					allErrs = append(allErrs, field.NotSupported(fldPath.Index(index).Child("facilities").Index(facilityIndex), facility, sets.List(availableFacilities)))
+				}
+			}
 			if rule.MessageContent != nil {
 				if rule.MessageContent.Regex == nil && rule.MessageContent.Exclude == nil {
 					allErrs = append(allErrs, field.Required(fldPath.Index(index).Child("messageContent"), "either .regex or .exclude has to be provided"))
@@ -133,12 +881,248 @@ func validateLoggingRules(loggingRules []rsyslog.LoggingRule, fldPath *field.Pat
 					allErrs = append(allErrs, field.Required(fldPath.Index(index).Child("messageContent").Child("exclude"), fmt.Sprintf("not a valid POSIX ERE regular expression: %v", err)))
 				}
 			}
+			for matcherIndex, matcher := range rule.PropertyMatchers {
+				allErrs = append(allErrs, validatePropertyMatcher(matcher, fldPath.Index(index).Child("propertyMatchers").Index(matcherIndex))...)
+			}
+			allErrs = append(allErrs, validateCondition(rule.Condition, fldPath.Index(index).Child("condition"))...)
+			allErrs = append(allErrs, validateRateLimit(rule.RateLimit, fldPath.Index(index).Child("rateLimit"))...)
+			if rule.Sampling != nil && rule.Sampling.OneIn < 2 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(index).Child("sampling", "oneIn"), rule.Sampling.OneIn, "oneIn must be greater than 1"))
+			}
+			for transformationIndex, transformation := range rule.Transformations {
+				allErrs = append(allErrs, validateTransformation(transformation, fldPath.Index(index).Child("transformations").Index(transformationIndex))...)
+			}
 		}
 	}
 
 	return allErrs
 }
 
+func validateTransformation(transformation rsyslog.Transformation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var setFields int
+	for _, isSet := range []bool{transformation.Severity != nil, transformation.SyslogTag != nil, transformation.AppName != nil, transformation.Field != nil} {
+		if isSet {
+			setFields++
+		}
+	}
+	switch {
+	case setFields == 0:
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one of .severity, .syslogTag, .appName or .field has to be provided"))
+	case setFields > 1:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of .severity, .syslogTag, .appName or .field can be set"))
+	}
+
+	allErrs = append(allErrs, validateSeverity(transformation.Severity, fldPath.Child("severity"))...)
+
+	if syslogTag := transformation.SyslogTag; syslogTag != nil && !syslogTagRegex.MatchString(*syslogTag) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syslogTag"), *syslogTag, "syslogTag must contain only printable characters except spaces and be between 1 and 32 characters long"))
+	}
+
+	if appName := transformation.AppName; appName != nil && !appNameRegex.MatchString(*appName) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("appName"), *appName, "appName must contain only printable characters except spaces and be between 1 and 48 characters long"))
+	}
+
+	if customField := transformation.Field; customField != nil {
+		if !metadataKeyRegex.MatchString(customField.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("field", "name"), customField.Name, "names must start with a letter, contain only letters, digits or `_` and be at most 32 characters long"))
+		} else if reservedMetadataKeys.Has(customField.Name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("field", "name"), fmt.Sprintf("name %q is reserved", customField.Name)))
+		}
+		if !metadataValueRegex.MatchString(customField.Value) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("field", "value"), customField.Value, "values can only contain printable characters except `\"`, `\\` and `]` and be at most 256 characters long"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateCustomFieldNames validates that the custom fields added by transformations do not overwrite the static
+// metadata, which is added to the log messages in the same way.
+func validateCustomFieldNames(config *rsyslog.RsyslogRelpConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	checkLoggingRules := func(loggingRules []rsyslog.LoggingRule, fldPath *field.Path) {
+		for index, rule := range loggingRules {
+			for transformationIndex, transformation := range rule.Transformations {
+				if customField := transformation.Field; customField != nil {
+					if _, ok := config.StaticMetadata[customField.Name]; ok {
+						allErrs = append(allErrs, field.Forbidden(fldPath.Index(index).Child("transformations").Index(transformationIndex).Child("field", "name"), fmt.Sprintf("name %q is already used in .staticMetadata", customField.Name)))
+					}
+				}
+			}
+		}
+	}
+
+	checkLoggingRules(config.LoggingRules, field.NewPath("loggingRules"))
+	for index, additionalTarget := range config.AdditionalTargets {
+		checkLoggingRules(additionalTarget.LoggingRules, field.NewPath("additionalTargets").Index(index).Child("loggingRules"))
+	}
+	for index, workerPool := range config.WorkerPools {
+		checkLoggingRules(workerPool.LoggingRules, field.NewPath("workerPools").Index(index).Child("loggingRules"))
+	}
+	if config.KernelLogs != nil {
+		checkLoggingRules(config.KernelLogs.LoggingRules, field.NewPath("kernelLogs", "loggingRules"))
+	}
+
+	return allErrs
+}
+
+func validateRateLimit(rateLimit *rsyslog.RateLimit, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rateLimit == nil {
+		return allErrs
+	}
+
+	if rateLimit.Interval <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), rateLimit.Interval, "interval must be greater than 0"))
+	}
+
+	if rateLimit.Burst <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("burst"), rateLimit.Burst, "burst must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validatePropertyMatcher(matcher rsyslog.PropertyMatcher, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case matcher.Property == nil && matcher.JournalField == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "either .property or .journalField has to be provided"))
+	case matcher.Property != nil && matcher.JournalField != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, ".property and .journalField cannot be set at the same time"))
+	}
+
+	if matcher.Property != nil && !availableMessageProperties.Has(string(*matcher.Property)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("property"), matcher.Property, sets.List(availableMessageProperties)))
+	}
+	if matcher.JournalField != nil && !journalFieldRegex.MatchString(*matcher.JournalField) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("journalField"), *matcher.JournalField, "journal fields must only contain uppercase letters, digits or `_`, must not start with a digit and be at most 32 characters long"))
+	}
+	if matcher.Operator != nil && !availablePropertyMatchOperators.Has(string(*matcher.Operator)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operator"), matcher.Operator, sets.List(availablePropertyMatchOperators)))
+	}
+
+	if matcher.Value == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("value"), "value cannot be empty"))
+	} else if ptr.Deref(matcher.Operator, rsyslog.PropertyMatchOperatorEquals) == rsyslog.PropertyMatchOperatorRegex {
+		if err := validateRegex(&matcher.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("value"), matcher.Value, fmt.Sprintf("not a valid POSIX ERE regular expression: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+const (
+	// maxConditionDepth is the maximum nesting depth of the condition of a logging rule.
+	maxConditionDepth = 5
+	// maxConditionSize is the maximum number of expressions in the condition of a logging rule.
+	maxConditionSize = 50
+)
+
+func validateCondition(condition *rsyslog.Condition, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if condition == nil {
+		return allErrs
+	}
+
+	size := 0
+	walkCondition(condition, fldPath, func(_ *rsyslog.Condition, _ *field.Path) { size++ })
+	if size > maxConditionSize {
+		return append(allErrs, field.TooMany(fldPath, size, maxConditionSize))
+	}
+
+	return validateConditionExpression(condition, 1, fldPath)
+}
+
+func validateConditionExpression(condition *rsyslog.Condition, depth int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if depth > maxConditionDepth {
+		return append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("conditions cannot be nested deeper than %d levels", maxConditionDepth)))
+	}
+
+	var setFields int
+	for _, isSet := range []bool{len(condition.AllOf) > 0, len(condition.AnyOf) > 0, condition.Not != nil, condition.Match != nil, condition.Severity != nil} {
+		if isSet {
+			setFields++
+		}
+	}
+	switch {
+	case setFields == 0:
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one of .allOf, .anyOf, .not, .match or .severity has to be provided"))
+	case setFields > 1:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of .allOf, .anyOf, .not, .match or .severity can be set"))
+	}
+
+	for index := range condition.AllOf {
+		allErrs = append(allErrs, validateConditionExpression(&condition.AllOf[index], depth+1, fldPath.Child("allOf").Index(index))...)
+	}
+	for index := range condition.AnyOf {
+		allErrs = append(allErrs, validateConditionExpression(&condition.AnyOf[index], depth+1, fldPath.Child("anyOf").Index(index))...)
+	}
+	if condition.Not != nil {
+		allErrs = append(allErrs, validateConditionExpression(condition.Not, depth+1, fldPath.Child("not"))...)
+	}
+	if condition.Match != nil {
+		allErrs = append(allErrs, validatePropertyMatcher(*condition.Match, fldPath.Child("match"))...)
+	}
+	allErrs = append(allErrs, validateSeverity(condition.Severity, fldPath.Child("severity"))...)
+
+	return allErrs
+}
+
+// walkCondition calls the given function for the condition and all of its nested conditions.
+func walkCondition(condition *rsyslog.Condition, fldPath *field.Path, fn func(*rsyslog.Condition, *field.Path)) {
+	if condition == nil {
+		return
+	}
+
+	fn(condition, fldPath)
+	for index := range condition.AllOf {
+		walkCondition(&condition.AllOf[index], fldPath.Child("allOf").Index(index), fn)
+	}
+	for index := range condition.AnyOf {
+		walkCondition(&condition.AnyOf[index], fldPath.Child("anyOf").Index(index), fn)
+	}
+	walkCondition(condition.Not, fldPath.Child("not"), fn)
+}
+
+func validateSeverity(severity *int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if severity != nil && (*severity < 0 || *severity > 7) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *severity, "severity must be between 0 and 7"))
+	}
+
+	return allErrs
+}
+
+func validateSeverityRange(severityRange *rsyslog.SeverityRange, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if severityRange == nil {
+		return allErrs
+	}
+
+	if severityRange.Min == nil && severityRange.Max == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "either .min or .max has to be provided"))
+	}
+	allErrs = append(allErrs, validateSeverity(severityRange.Min, fldPath.Child("min"))...)
+	allErrs = append(allErrs, validateSeverity(severityRange.Max, fldPath.Child("max"))...)
+	if severityRange.Min != nil && severityRange.Max != nil && *severityRange.Min > *severityRange.Max {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("min"), *severityRange.Min, "min cannot be greater than max"))
+	}
+
+	return allErrs
+}
+
 func validateProgramNames(programNames []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for index, name := range programNames {
@@ -148,6 +1132,9 @@ func validateProgramNames(programNames []string, fldPath *field.Path) field.Erro
 		if invalidCharactersForProgramNameRegex.MatchString(name) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Index(index), name, ".programNames can't contain `[`, `:` or `/`"))
 		}
+		if onlyWildcardsProgramNameRegex.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(index), name, ".programNames patterns must contain at least one character other than `*` or `?`"))
+		}
 		if !printableCharactersRegex.MatchString(name) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Index(index), name, ".programNames can only contain printable characters"))
 		}
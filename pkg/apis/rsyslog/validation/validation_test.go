@@ -5,10 +5,13 @@
 package validation_test
 
 import (
+	"slices"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -34,6 +37,11 @@ var _ = Describe("Validation", func() {
 			tlsLibGnuTLS  rsyslog.TLSLib = "gnutls"
 			tlsLibInvalid rsyslog.TLSLib = "invalid"
 
+			outputFormatRFC5424 rsyslog.OutputFormat = "rfc5424"
+			outputFormatRFC3164 rsyslog.OutputFormat = "rfc3164"
+			outputFormatJSON    rsyslog.OutputFormat = "json"
+			outputFormatInvalid rsyslog.OutputFormat = "invalid"
+
 			loggingRules = []rsyslog.LoggingRule{
 				{
 					ProgramNames: []string{"kubelet"},
@@ -215,6 +223,32 @@ var _ = Describe("Validation", func() {
 			Expect(errorList).To(matcher)
 		})
 
+		It("should only allow a logging rule with set programNames to have patterns with literal characters", func() {
+			config := rsyslog.RsyslogRelpConfig{
+				Target:       relpTarget,
+				Port:         relpTargetPort,
+				LoggingRules: []rsyslog.LoggingRule{{ProgramNames: []string{"kube*", "containerd-shim-*-v?", "*", "?*"}, Severity: ptr.To(4)}},
+			}
+
+			matcher := ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeInvalid),
+					"Field":    Equal("loggingRules.programNames[2]"),
+					"BadValue": Equal("*"),
+					"Detail":   Equal(".programNames patterns must contain at least one character other than `*` or `?`"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":     Equal(field.ErrorTypeInvalid),
+					"Field":    Equal("loggingRules.programNames[3]"),
+					"BadValue": Equal("?*"),
+					"Detail":   Equal(".programNames patterns must contain at least one character other than `*` or `?`"),
+				})),
+			)
+
+			errorList := validation.ValidateRsyslogRelpConfig(&config, path)
+			Expect(errorList).To(matcher)
+		})
+
 		It("should not allow a logging rule with set programNames to have non-printable ASCI characters in the names", func() {
 			config := rsyslog.RsyslogRelpConfig{
 				Target:       relpTarget,
@@ -249,7 +283,7 @@ var _ = Describe("Validation", func() {
 					"Type":     Equal(field.ErrorTypeRequired),
 					"Field":    Equal("loggingRules[0]"),
 					"BadValue": Equal(""),
-					"Detail":   Equal("at least one of .programNames, .messageContent, or .severity is required"),
+					"Detail":   Equal("at least one of .programNames, .systemdUnits, .facilities, .messageContent, .propertyMatchers, .condition, .severity, or .severityRange is required"),
 				})),
 			)
 
@@ -320,6 +354,119 @@ var _ = Describe("Validation", func() {
 					rsyslog.RsyslogRelpConfig{Target: relpTarget, Port: relpTargetPort, TLS: &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("secretRef"), PermittedPeer: []string{"per"}, AuthMode: &authModeName}, LoggingRules: loggingRules, RebindInterval: ptr.To(1000), Timeout: ptr.To(90), ResumeRetryCount: ptr.To(10), ReportSuspensionContinuation: ptr.To(true)},
 					BeEmpty(),
 				),
+
+				Entry("should allow config when output format is rfc5424",
+					rsyslog.RsyslogRelpConfig{Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules, OutputFormat: &outputFormatRFC5424},
+					BeEmpty(),
+				),
+
+				Entry("should allow config when output format is rfc3164",
+					rsyslog.RsyslogRelpConfig{Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules, OutputFormat: &outputFormatRFC3164},
+					BeEmpty(),
+				),
+
+				Entry("should allow config when output format is json",
+					rsyslog.RsyslogRelpConfig{Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules, OutputFormat: &outputFormatJSON},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when output format is invalid",
+					rsyslog.RsyslogRelpConfig{Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules, OutputFormat: &outputFormatInvalid},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeNotSupported),
+							"Field":    Equal("outputFormat"),
+							"BadValue": Equal(&outputFormatInvalid),
+							"Detail":   Equal(`supported values: "json", "rfc3164", "rfc5424"`),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Static Metadata Configuration",
+				func(staticMetadata map[string]string, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:         relpTarget,
+						Port:           relpTargetPort,
+						LoggingRules:   loggingRules,
+						StaticMetadata: staticMetadata,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when static metadata is correct",
+					map[string]string{"costCenter": "12345", "environment": "prod", "data_classification": "internal use only"},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when static metadata keys are invalid",
+					map[string]string{"1costCenter": "12345", "cost-center": "12345", "": "12345"},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("staticMetadata"),
+							"BadValue": Equal("1costCenter"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("staticMetadata"),
+							"BadValue": Equal("cost-center"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("staticMetadata"),
+							"BadValue": Equal(""),
+						})),
+					),
+				),
+
+				Entry("should forbid config when static metadata keys are reserved",
+					map[string]string{"shootName": "foo", "msg": "bar", "workerPool": "baz"},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("staticMetadata[workerPool]"),
+							"Detail": Equal(`key "workerPool" is reserved`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("staticMetadata[shootName]"),
+							"Detail": Equal(`key "shootName" is reserved`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("staticMetadata[msg]"),
+							"Detail": Equal(`key "msg" is reserved`),
+						})),
+					),
+				),
+
+				Entry("should forbid config when static metadata values contain invalid characters",
+					map[string]string{"quote": `"prod"`, "backslash": `C:\prod`, "bracket": "prod]", "newline": "prod\n"},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("staticMetadata[quote]"),
+							"BadValue": Equal(`"prod"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("staticMetadata[backslash]"),
+							"BadValue": Equal(`C:\prod`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("staticMetadata[bracket]"),
+							"BadValue": Equal("prod]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("staticMetadata[newline]"),
+							"BadValue": Equal("prod\n"),
+						})),
+					),
+				),
 			)
 
 			DescribeTable("TLS Configuration",
@@ -459,6 +606,1729 @@ var _ = Describe("Validation", func() {
 					),
 				),
 			)
+
+			DescribeTable("Additional Targets Configuration",
+				func(additionalTargets []rsyslog.RelpTarget, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:            relpTarget,
+						Port:              relpTargetPort,
+						LoggingRules:      loggingRules,
+						TLS:               &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("secret-name"), TLSLib: &tlsLibOpenSSL},
+						AdditionalTargets: additionalTargets,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when additional targets are correct",
+					[]rsyslog.RelpTarget{
+						{Name: "security", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules},
+						{Name: "platform", Target: "10.0.0.1", Port: 10251, LoggingRules: loggingRules, TLS: &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("other-secret"), TLSLib: &tlsLibOpenSSL}, Timeout: ptr.To(90)},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when name, target and loggingRules of an additional target are not set",
+					[]rsyslog.RelpTarget{{Port: relpTargetPort}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeRequired),
+							"Field":  Equal("additionalTargets[0].name"),
+							"Detail": Equal("name must not be empty"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeRequired),
+							"Field":  Equal("additionalTargets[0].target"),
+							"Detail": Equal("target must not be empty"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeRequired),
+							"Field":  Equal("additionalTargets[0].loggingRules"),
+							"Detail": Equal("at least one logging rule is required"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when names of additional targets are invalid, duplicated or reserved",
+					[]rsyslog.RelpTarget{
+						{Name: "security", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules},
+						{Name: "security", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules},
+						{Name: "Platform_Team", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules},
+						{Name: "failover", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules},
+						{Name: "vali", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules},
+						{Name: "local-copy", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules},
+						{Name: "redaction", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules},
+						{Name: "audit", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules},
+						{Name: "kubernetes", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("additionalTargets[3].name"),
+							"Detail": Equal(`name "failover" is reserved`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("additionalTargets[4].name"),
+							"Detail": Equal(`name "vali" is reserved`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("additionalTargets[5].name"),
+							"Detail": Equal(`name "local-copy" is reserved`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("additionalTargets[6].name"),
+							"Detail": Equal(`name "redaction" is reserved`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("additionalTargets[7].name"),
+							"Detail": Equal(`name "audit" is reserved`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("additionalTargets[8].name"),
+							"Detail": Equal(`name "kubernetes" is reserved`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeDuplicate),
+							"Field":    Equal("additionalTargets[1].name"),
+							"BadValue": Equal("security"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("additionalTargets[2].name"),
+							"BadValue": Equal("Platform_Team"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when port or tls of an additional target are invalid",
+					[]rsyslog.RelpTarget{
+						{Name: "security", Target: relpTarget, Port: -1, LoggingRules: loggingRules, TLS: &rsyslog.TLS{Enabled: true}},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("additionalTargets[0].port"),
+							"BadValue": Equal(-1),
+							"Detail":   Equal("port cannot be less than 0"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeRequired),
+							"Field":  Equal("additionalTargets[0].tls.secretReferenceName"),
+							"Detail": Equal("secretReferenceName must not be empty when tls is enabled"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when an additional target uses a different tls lib",
+					[]rsyslog.RelpTarget{
+						{Name: "security", Target: relpTarget, Port: relpTargetPort, LoggingRules: loggingRules, TLS: &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("other-secret"), TLSLib: &tlsLibGnuTLS}},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("additionalTargets[0].tls.tlsLib"),
+							"BadValue": Equal(tlsLibGnuTLS),
+							"Detail":   Equal(`all targets must use the same tls library, "openssl" is already configured`),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Failover Target Configuration",
+				func(failoverTarget rsyslog.FailoverTarget, resumeRetryCount *int, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:           relpTarget,
+						Port:             relpTargetPort,
+						LoggingRules:     loggingRules,
+						ResumeRetryCount: resumeRetryCount,
+						TLS:              &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("secret-name"), TLSLib: &tlsLibOpenSSL},
+						FailoverTarget:   &failoverTarget,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when failover target is correct",
+					rsyslog.FailoverTarget{Target: "standby.rsyslog.relp.server", Port: relpTargetPort, TLS: &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("standby-secret")}, ResumeRetryCount: ptr.To(-1)},
+					ptr.To(3),
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when target and port of the failover target are invalid",
+					rsyslog.FailoverTarget{Port: -1},
+					nil,
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeRequired),
+							"Field":  Equal("failoverTarget.target"),
+							"Detail": Equal("target must not be empty"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("failoverTarget.port"),
+							"BadValue": Equal(-1),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the primary target retries endlessly",
+					rsyslog.FailoverTarget{Target: "standby.rsyslog.relp.server", Port: relpTargetPort},
+					ptr.To(-1),
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("resumeRetryCount"),
+							"BadValue": Equal(-1),
+							"Detail":   Equal("resumeRetryCount must not be -1 when a failover target is configured"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the failover target uses a different tls lib",
+					rsyslog.FailoverTarget{Target: "standby.rsyslog.relp.server", Port: relpTargetPort, TLS: &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("standby-secret"), TLSLib: &tlsLibGnuTLS}},
+					nil,
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("failoverTarget.tls.tlsLib"),
+							"BadValue": Equal(tlsLibGnuTLS),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Worker Pools Configuration",
+				func(workerPools []rsyslog.WorkerPool, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: loggingRules,
+						WorkerPools:  workerPools,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when worker pools are correct",
+					[]rsyslog.WorkerPool{
+						{Name: "pci", Target: ptr.To("pci.rsyslog.relp.server"), Port: ptr.To(10251), AuditConfig: &rsyslog.AuditConfig{Enabled: true, ConfigMapReferenceName: ptr.To("pci-audit-rules")}},
+						{Name: "dev", LoggingRules: []rsyslog.LoggingRule{{Severity: ptr.To(3)}}, AuditConfig: &rsyslog.AuditConfig{Enabled: false}, Queue: &rsyslog.Queue{Size: ptr.To(1000)}},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when names of worker pools are empty, invalid or duplicated",
+					[]rsyslog.WorkerPool{{}, {Name: "Pool_1"}, {Name: "dev"}, {Name: "dev"}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("workerPools[0].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("workerPools[1].name"),
+							"BadValue": Equal("Pool_1"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeDuplicate),
+							"Field":    Equal("workerPools[3].name"),
+							"BadValue": Equal("dev"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when overrides of a worker pool are invalid",
+					[]rsyslog.WorkerPool{{Name: "dev", Target: ptr.To(""), Port: ptr.To(-1), LoggingRules: []rsyslog.LoggingRule{}, Queue: &rsyslog.Queue{Size: ptr.To(0)}}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("workerPools[0].target"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("workerPools[0].port"),
+							"BadValue": Equal(-1),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeRequired),
+							"Field":  Equal("workerPools[0].loggingRules"),
+							"Detail": Equal("at least one logging rule is required"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("workerPools[0].queue.size"),
+							"BadValue": Equal(0),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Input Configuration",
+				func(input *rsyslog.Input, rules []rsyslog.LoggingRule, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: rules,
+						Input:        input,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when input mode is imuxsock",
+					&rsyslog.Input{Mode: ptr.To(rsyslog.InputModeImuxsock)},
+					loggingRules,
+					BeEmpty(),
+				),
+
+				Entry("should allow config when journal settings are used with input mode imjournal",
+					&rsyslog.Input{Mode: ptr.To(rsyslog.InputModeImjournal), ReplayJournal: ptr.To(true), JournalFields: []string{"_SYSTEMD_UNIT", "_BOOT_ID"}},
+					[]rsyslog.LoggingRule{{SystemdUnits: []string{"kubelet.service", "containerd.service"}}},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when input mode is invalid",
+					&rsyslog.Input{Mode: ptr.To(rsyslog.InputMode("imfile"))},
+					loggingRules,
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeNotSupported),
+							"Field":  Equal("input.mode"),
+							"Detail": Equal(`supported values: "imjournal", "imuxsock"`),
+						})),
+					),
+				),
+
+				Entry("should forbid config when journal settings are used without input mode imjournal",
+					&rsyslog.Input{ReplayJournal: ptr.To(false), JournalFields: []string{"_SYSTEMD_UNIT"}},
+					[]rsyslog.LoggingRule{{SystemdUnits: []string{"kubelet.service"}}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("input.replayJournal"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("input.journalFields"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("loggingRules[0].systemdUnits"),
+							"Detail": Equal(`can only be set if the input mode is "imjournal"`),
+						})),
+					),
+				),
+
+				Entry("should forbid config when journal fields and systemd units are invalid",
+					&rsyslog.Input{Mode: ptr.To(rsyslog.InputModeImjournal), JournalFields: []string{"_systemd_unit", "MESSAGE", "MESSAGE"}},
+					[]rsyslog.LoggingRule{{SystemdUnits: []string{"kubelet service"}}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("input.journalFields[0]"),
+							"BadValue": Equal("_systemd_unit"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeDuplicate),
+							"Field":    Equal("input.journalFields[2]"),
+							"BadValue": Equal("MESSAGE"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].systemdUnits[0]"),
+							"BadValue": Equal("kubelet service"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Property Matchers Configuration",
+				func(input *rsyslog.Input, propertyMatchers []rsyslog.PropertyMatcher, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: []rsyslog.LoggingRule{{PropertyMatchers: propertyMatchers}},
+						Input:        input,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when the property matchers are correct",
+					&rsyslog.Input{Mode: ptr.To(rsyslog.InputModeImjournal)},
+					[]rsyslog.PropertyMatcher{
+						{Property: ptr.To(rsyslog.MessagePropertyFacility), Value: "authpriv"},
+						{JournalField: ptr.To("_SYSTEMD_UNIT"), Operator: ptr.To(rsyslog.PropertyMatchOperatorStartsWith), Value: "kube"},
+						{Property: ptr.To(rsyslog.MessagePropertyHostname), Operator: ptr.To(rsyslog.PropertyMatchOperatorRegex), Value: "^node-[0-9]+$"},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when journal fields are matched without input mode imjournal",
+					nil,
+					[]rsyslog.PropertyMatcher{
+						{JournalField: ptr.To("_SYSTEMD_UNIT"), Value: "kubelet.service"},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("loggingRules[0].propertyMatchers[0].journalField"),
+							"Detail": Equal(`can only be set if the input mode is "imjournal"`),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the property matchers are invalid",
+					&rsyslog.Input{Mode: ptr.To(rsyslog.InputModeImjournal)},
+					[]rsyslog.PropertyMatcher{
+						{},
+						{Property: ptr.To(rsyslog.MessagePropertyHostname), JournalField: ptr.To("_HOSTNAME"), Value: "node"},
+						{Property: ptr.To(rsyslog.MessageProperty("tag")), Operator: ptr.To(rsyslog.PropertyMatchOperator("contains")), Value: "kube"},
+						{JournalField: ptr.To("_systemd_unit"), Value: "kubelet.service"},
+						{Property: ptr.To(rsyslog.MessagePropertySyslogTag), Operator: ptr.To(rsyslog.PropertyMatchOperatorRegex), Value: "kube(let"},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("loggingRules[0].propertyMatchers[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("loggingRules[0].propertyMatchers[0].value"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("loggingRules[0].propertyMatchers[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("loggingRules[0].propertyMatchers[2].property"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("loggingRules[0].propertyMatchers[2].operator"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].propertyMatchers[3].journalField"),
+							"BadValue": Equal("_systemd_unit"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].propertyMatchers[4].value"),
+							"BadValue": Equal("kube(let"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Condition Configuration",
+				func(input *rsyslog.Input, condition *rsyslog.Condition, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: []rsyslog.LoggingRule{{Condition: condition}},
+						Input:        input,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when the condition is correct",
+					nil,
+					&rsyslog.Condition{AllOf: []rsyslog.Condition{
+						{AnyOf: []rsyslog.Condition{
+							{Match: &rsyslog.PropertyMatcher{Property: ptr.To(rsyslog.MessagePropertyProgramName), Value: "kubelet"}},
+							{Match: &rsyslog.PropertyMatcher{Property: ptr.To(rsyslog.MessagePropertyProgramName), Value: "containerd"}},
+						}},
+						{Not: &rsyslog.Condition{Match: &rsyslog.PropertyMatcher{Property: ptr.To(rsyslog.MessagePropertyMessage), Operator: ptr.To(rsyslog.PropertyMatchOperatorRegex), Value: "healthz"}}},
+						{Severity: ptr.To(6)},
+					}},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when the condition is invalid",
+					nil,
+					&rsyslog.Condition{AllOf: []rsyslog.Condition{
+						{},
+						{Severity: ptr.To(9), Not: &rsyslog.Condition{Severity: ptr.To(1)}},
+						{Match: &rsyslog.PropertyMatcher{Property: ptr.To(rsyslog.MessagePropertyHostname)}},
+						{Match: &rsyslog.PropertyMatcher{JournalField: ptr.To("_SYSTEMD_UNIT"), Value: "kubelet.service"}},
+					}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("loggingRules[0].condition.allOf[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("loggingRules[0].condition.allOf[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].condition.allOf[1].severity"),
+							"BadValue": Equal(9),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("loggingRules[0].condition.allOf[2].match.value"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("loggingRules[0].condition.allOf[3].match.journalField"),
+							"Detail": Equal(`can only be set if the input mode is "imjournal"`),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the condition is nested too deeply",
+					nil,
+					&rsyslog.Condition{Not: &rsyslog.Condition{Not: &rsyslog.Condition{Not: &rsyslog.Condition{Not: &rsyslog.Condition{Not: &rsyslog.Condition{Severity: ptr.To(3)}}}}}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("loggingRules[0].condition.not.not.not.not.not"),
+							"Detail": Equal("conditions cannot be nested deeper than 5 levels"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the condition has too many expressions",
+					nil,
+					&rsyslog.Condition{AnyOf: slices.Repeat([]rsyslog.Condition{{Severity: ptr.To(3)}}, 50)},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeTooMany),
+							"Field":    Equal("loggingRules[0].condition"),
+							"BadValue": Equal(51),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Severity Configuration",
+				func(rule rsyslog.LoggingRule, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: []rsyslog.LoggingRule{rule},
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when the severity range is correct",
+					rsyslog.LoggingRule{SeverityRange: &rsyslog.SeverityRange{Min: ptr.To(4), Max: ptr.To(4)}},
+					BeEmpty(),
+				),
+
+				Entry("should allow config when the severity range is open",
+					rsyslog.LoggingRule{SeverityRange: &rsyslog.SeverityRange{Min: ptr.To(3)}},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when the severity is out of range",
+					rsyslog.LoggingRule{Severity: ptr.To(8)},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].severity"),
+							"BadValue": Equal(8),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the severity range is empty",
+					rsyslog.LoggingRule{SeverityRange: &rsyslog.SeverityRange{}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("loggingRules[0].severityRange"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the severity range is invalid",
+					rsyslog.LoggingRule{Severity: ptr.To(5), SeverityRange: &rsyslog.SeverityRange{Min: ptr.To(6), Max: ptr.To(-1)}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].severityRange.max"),
+							"BadValue": Equal(-1),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].severityRange.min"),
+							"BadValue": Equal(6),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("loggingRules[0].severityRange"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Exclude Rules Configuration",
+				func(excludeRules []rsyslog.LoggingRule, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: loggingRules,
+						ExcludeRules: excludeRules,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when no exclude rules are set",
+					nil,
+					BeEmpty(),
+				),
+
+				Entry("should allow config when the exclude rules are correct",
+					[]rsyslog.LoggingRule{
+						{ProgramNames: []string{"kubelet"}, MessageContent: &rsyslog.MessageContent{Regex: ptr.To("Probe succeeded")}},
+						{PropertyMatchers: []rsyslog.PropertyMatcher{{Property: ptr.To(rsyslog.MessagePropertyMessage), Operator: ptr.To(rsyslog.PropertyMatchOperatorRegex), Value: "GET /healthz"}}},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when the exclude rules are invalid",
+					[]rsyslog.LoggingRule{
+						{},
+						{SystemdUnits: []string{"kubelet.service"}},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("excludeRules[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("excludeRules[1].systemdUnits"),
+							"Detail": Equal(`can only be set if the input mode is "imjournal"`),
+						})),
+					),
+				),
+
+				Entry("should forbid config when exclude rules are rate limited or sampled",
+					[]rsyslog.LoggingRule{
+						{ProgramNames: []string{"kubelet"}, RateLimit: &rsyslog.RateLimit{Interval: 10, Burst: 100}},
+						{ProgramNames: []string{"containerd"}, Sampling: &rsyslog.Sampling{OneIn: 10}},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("excludeRules[0].rateLimit"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("excludeRules[1].sampling"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Rate Limit and Sampling Configuration",
+				func(loggingRules []rsyslog.LoggingRule, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: loggingRules,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when rate limits and sampling are correct",
+					[]rsyslog.LoggingRule{
+						{ProgramNames: []string{"audisp-syslog"}, Severity: ptr.To(7)},
+						{ProgramNames: []string{"kubelet"}, Severity: ptr.To(7), RateLimit: &rsyslog.RateLimit{Interval: 10, Burst: 100}},
+						{ProgramNames: []string{"containerd"}, Sampling: &rsyslog.Sampling{OneIn: 10}},
+						{Severity: ptr.To(7), RateLimit: &rsyslog.RateLimit{Interval: 1, Burst: 1}, Sampling: &rsyslog.Sampling{OneIn: 2}},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when rate limits and sampling are invalid",
+					[]rsyslog.LoggingRule{
+						{ProgramNames: []string{"kubelet"}, RateLimit: &rsyslog.RateLimit{Interval: 0, Burst: -1}},
+						{ProgramNames: []string{"containerd"}, Sampling: &rsyslog.Sampling{OneIn: 1}},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].rateLimit.interval"),
+							"BadValue": Equal(0),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].rateLimit.burst"),
+							"BadValue": Equal(-1),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[1].sampling.oneIn"),
+							"BadValue": Equal(1),
+							"Detail":   Equal("oneIn must be greater than 1"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Transformations Configuration",
+				func(config rsyslog.RsyslogRelpConfig, matcher gomegatypes.GomegaMatcher) {
+					config.Target = relpTarget
+					config.Port = relpTargetPort
+					errorList := validation.ValidateRsyslogRelpConfig(&config, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when the transformations are correct",
+					rsyslog.RsyslogRelpConfig{
+						LoggingRules: []rsyslog.LoggingRule{
+							{
+								ProgramNames: []string{"audisp-syslog"},
+								Transformations: []rsyslog.Transformation{
+									{Severity: ptr.To(4)},
+									{SyslogTag: ptr.To("audit:")},
+									{AppName: ptr.To("audit")},
+									{Field: &rsyslog.CustomField{Name: "category", Value: "security"}},
+								},
+							},
+						},
+						KernelLogs: &rsyslog.KernelLogs{
+							Enabled: true,
+							LoggingRules: []rsyslog.LoggingRule{
+								{Severity: ptr.To(7), Transformations: []rsyslog.Transformation{{SyslogTag: ptr.To("kernel:")}}},
+							},
+						},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when none or more than one field of a transformation is set",
+					rsyslog.RsyslogRelpConfig{
+						LoggingRules: []rsyslog.LoggingRule{
+							{
+								ProgramNames: []string{"audisp-syslog"},
+								Transformations: []rsyslog.Transformation{
+									{},
+									{SyslogTag: ptr.To("audit:"), AppName: ptr.To("audit")},
+								},
+							},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("loggingRules[0].transformations[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("loggingRules[0].transformations[1]"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the transformations are invalid",
+					rsyslog.RsyslogRelpConfig{
+						StaticMetadata: map[string]string{"team": "platform"},
+						LoggingRules: []rsyslog.LoggingRule{
+							{
+								ProgramNames: []string{"audisp-syslog"},
+								Transformations: []rsyslog.Transformation{
+									{Severity: ptr.To(8)},
+									{SyslogTag: ptr.To("audit tag:")},
+									{AppName: ptr.To("")},
+									{Field: &rsyslog.CustomField{Name: "1category", Value: "security"}},
+									{Field: &rsyslog.CustomField{Name: "msg", Value: `"quoted"`}},
+								},
+							},
+						},
+						AdditionalTargets: []rsyslog.RelpTarget{
+							{
+								Name:   "security",
+								Target: relpTarget,
+								Port:   relpTargetPort,
+								LoggingRules: []rsyslog.LoggingRule{
+									{Severity: ptr.To(7), Transformations: []rsyslog.Transformation{{Field: &rsyslog.CustomField{Name: "team", Value: "security"}}}},
+								},
+							},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].transformations[0].severity"),
+							"BadValue": Equal(8),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].transformations[1].syslogTag"),
+							"BadValue": Equal("audit tag:"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].transformations[2].appName"),
+							"BadValue": Equal(""),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].transformations[3].field.name"),
+							"BadValue": Equal("1category"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("loggingRules[0].transformations[4].field.name"),
+							"Detail": Equal(`name "msg" is reserved`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("loggingRules[0].transformations[4].field.value"),
+							"BadValue": Equal(`"quoted"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("additionalTargets[0].loggingRules[0].transformations[0].field.name"),
+							"Detail": Equal(`name "team" is already used in .staticMetadata`),
+						})),
+					),
+				),
+
+				Entry("should forbid config when exclude rules have transformations",
+					rsyslog.RsyslogRelpConfig{
+						LoggingRules: loggingRules,
+						ExcludeRules: []rsyslog.LoggingRule{
+							{ProgramNames: []string{"kubelet"}, Transformations: []rsyslog.Transformation{{SyslogTag: ptr.To("kubelet:")}}},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("excludeRules[0].transformations"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Container Logs Configuration",
+				func(containerLogs rsyslog.ContainerLogs, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:        relpTarget,
+						Port:          relpTargetPort,
+						LoggingRules:  loggingRules,
+						ContainerLogs: &containerLogs,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when container logs are enabled for namespaces and pods",
+					rsyslog.ContainerLogs{
+						Enabled:    true,
+						Namespaces: []string{"kube-system", "default"},
+						PodSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"app.kubernetes.io/name": "foo"},
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"frontend", "backend"}},
+							},
+						},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when namespaces are invalid",
+					rsyslog.ContainerLogs{
+						Enabled:    true,
+						Namespaces: []string{"Kube_System", "default", "default"},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("containerLogs.namespaces[0]"),
+							"BadValue": Equal("Kube_System"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeDuplicate),
+							"Field":    Equal("containerLogs.namespaces[2]"),
+							"BadValue": Equal("default"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when pod selector is invalid",
+					rsyslog.ContainerLogs{
+						Enabled: true,
+						PodSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "tier", Operator: metav1.LabelSelectorOpExists, Values: []string{"frontend"}},
+							},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("containerLogs.podSelector.matchExpressions[0].values"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Kernel Logs Configuration",
+				func(kernelLogs rsyslog.KernelLogs, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: loggingRules,
+						KernelLogs:   &kernelLogs,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when kernel logs are enabled without logging rules",
+					rsyslog.KernelLogs{Enabled: true},
+					BeEmpty(),
+				),
+
+				Entry("should allow config when kernel logs are enabled with logging rules",
+					rsyslog.KernelLogs{
+						Enabled: true,
+						LoggingRules: []rsyslog.LoggingRule{
+							{Facilities: []string{"kern"}, Severity: ptr.To(4)},
+							{MessageContent: &rsyslog.MessageContent{Regex: ptr.To("apparmor=\"DENIED\"")}},
+						},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when facilities of logging rules are invalid",
+					rsyslog.KernelLogs{
+						Enabled: true,
+						LoggingRules: []rsyslog.LoggingRule{
+							{Facilities: []string{"kern", "kernel"}},
+							{},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeNotSupported),
+							"Field":    Equal("kernelLogs.loggingRules[0].facilities[1]"),
+							"BadValue": Equal("kernel"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("kernelLogs.loggingRules[1]"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Local Copy Configuration",
+				func(localCopy rsyslog.LocalCopy, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: loggingRules,
+						LocalCopy:    &localCopy,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when the local copy settings are correct",
+					rsyslog.LocalCopy{Enabled: true, MaxSize: ptr.To("1g"), MaxFiles: ptr.To(10), MaxAge: ptr.To(1440)},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when the local copy settings are invalid",
+					rsyslog.LocalCopy{Enabled: true, MaxSize: ptr.To("1 GB"), MaxFiles: ptr.To(0), MaxAge: ptr.To(-1)},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("localCopy.maxSize"),
+							"BadValue": Equal("1 GB"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("localCopy.maxFiles"),
+							"BadValue": Equal(0),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("localCopy.maxAge"),
+							"BadValue": Equal(-1),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Audit Rules Configuration",
+				func(auditConfig rsyslog.AuditConfig, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: loggingRules,
+						AuditConfig:  &auditConfig,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when the audit rules are correct",
+					rsyslog.AuditConfig{
+						Enabled: true,
+						Rules: &rsyslog.AuditRules{
+							FileWatches: []rsyslog.AuditFileWatch{
+								{Path: "/etc/passwd", Permissions: "wa", Key: ptr.To("identity")},
+								{Path: "/var/lib/kubelet", Permissions: "rwxa"},
+							},
+							SyscallRules: []rsyslog.AuditSyscallRule{
+								{
+									Arch:     ptr.To(rsyslog.AuditArchB64),
+									Syscalls: []string{"execve", "execveat"},
+									Filters: []rsyslog.AuditFieldFilter{
+										{Field: "euid", Value: "0"},
+										{Field: "auid", Operator: ptr.To(rsyslog.AuditFilterOperatorGreaterThanOrEqual), Value: "1000"},
+										{Field: "auid", Operator: ptr.To(rsyslog.AuditFilterOperatorNotEquals), Value: "unset"},
+									},
+									Key: ptr.To("privilege_escalation"),
+								},
+								{Syscalls: []string{"mount"}, Filters: []rsyslog.AuditFieldFilter{{Field: "exe", Operator: ptr.To(rsyslog.AuditFilterOperatorNotEquals), Value: "/usr/bin/containerd"}}},
+							},
+							ExclusionRules: []rsyslog.AuditExclusionRule{
+								{Filters: []rsyslog.AuditFieldFilter{{Field: "msgtype", Value: "CWD"}}},
+								{Filters: []rsyslog.AuditFieldFilter{{Field: "exe", Value: "/usr/bin/chronyd"}, {Field: "uid", Value: "chrony"}}},
+							},
+						},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when audit rules and a configmap reference are set",
+					rsyslog.AuditConfig{
+						Enabled:                true,
+						ConfigMapReferenceName: ptr.To("audit-config"),
+						Rules:                  &rsyslog.AuditRules{FileWatches: []rsyslog.AuditFileWatch{{Path: "/etc/passwd", Permissions: "wa"}}},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("auditConfig.rules"),
+							"Detail": Equal("rules cannot be set together with configMapReferenceName"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the audit rules contain neither file watches nor syscall rules",
+					rsyslog.AuditConfig{
+						Enabled: true,
+						Rules: &rsyslog.AuditRules{
+							ExclusionRules: []rsyslog.AuditExclusionRule{{Filters: []rsyslog.AuditFieldFilter{{Field: "msgtype", Value: "CWD"}}}},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("auditConfig.rules"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the file watches are invalid",
+					rsyslog.AuditConfig{
+						Enabled: true,
+						Rules: &rsyslog.AuditRules{
+							FileWatches: []rsyslog.AuditFileWatch{
+								{Path: "etc/passwd", Permissions: "rwq"},
+								{Path: "/etc/../root/", Permissions: "rr", Key: ptr.To("my key")},
+								{Path: "/etc/shadow -k foo", Permissions: "r"},
+							},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.fileWatches[0].path"),
+							"BadValue": Equal("etc/passwd"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.fileWatches[0].permissions"),
+							"BadValue": Equal("rwq"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.fileWatches[1].path"),
+							"BadValue": Equal("/etc/../root/"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("auditConfig.rules.fileWatches[1].permissions"),
+							"Detail": Equal("permissions must not contain a permission more than once"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.fileWatches[1].key"),
+							"BadValue": Equal("my key"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.fileWatches[2].path"),
+							"BadValue": Equal("/etc/shadow -k foo"),
+						})),
+					),
+				),
+
+				Entry("should allow directory watches with a single trailing slash",
+					rsyslog.AuditConfig{
+						Enabled: true,
+						Rules: &rsyslog.AuditRules{
+							FileWatches: []rsyslog.AuditFileWatch{
+								{Path: "/etc/sudoers.d/", Permissions: "wa"},
+								{Path: "/", Permissions: "wa"},
+							},
+						},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid directory watches with repeated trailing slashes",
+					rsyslog.AuditConfig{
+						Enabled: true,
+						Rules: &rsyslog.AuditRules{
+							FileWatches: []rsyslog.AuditFileWatch{
+								{Path: "/etc/sudoers.d//", Permissions: "wa"},
+								{Path: "//", Permissions: "wa"},
+							},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.fileWatches[0].path"),
+							"BadValue": Equal("/etc/sudoers.d//"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.fileWatches[1].path"),
+							"BadValue": Equal("//"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the syscall rules are invalid",
+					rsyslog.AuditConfig{
+						Enabled: true,
+						Rules: &rsyslog.AuditRules{
+							SyscallRules: []rsyslog.AuditSyscallRule{
+								{
+									Arch:     ptr.To(rsyslog.AuditArch("x86")),
+									Syscalls: []string{"execve", "Execve", "execve"},
+									Filters: []rsyslog.AuditFieldFilter{
+										{Field: "key", Value: "foo"},
+										{Field: "auid", Value: "1000 -F uid=0"},
+										{Field: "exe", Operator: ptr.To(rsyslog.AuditFilterOperatorLessThan), Value: "/usr/bin/su"},
+										{Field: "exit", Operator: ptr.To(rsyslog.AuditFilterOperator("matches")), Value: "EPERM"},
+										{Field: "perm", Value: "rwz"},
+									},
+								},
+								{},
+							},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeNotSupported),
+							"Field":    Equal("auditConfig.rules.syscallRules[0].arch"),
+							"BadValue": Equal(rsyslog.AuditArch("x86")),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.syscallRules[0].syscalls[1]"),
+							"BadValue": Equal("Execve"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeDuplicate),
+							"Field":    Equal("auditConfig.rules.syscallRules[0].syscalls[2]"),
+							"BadValue": Equal("execve"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeNotSupported),
+							"Field":    Equal("auditConfig.rules.syscallRules[0].filters[0].field"),
+							"BadValue": Equal("key"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.syscallRules[0].filters[1].value"),
+							"BadValue": Equal("1000 -F uid=0"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeNotSupported),
+							"Field":    Equal("auditConfig.rules.syscallRules[0].filters[2].operator"),
+							"BadValue": Equal(rsyslog.AuditFilterOperatorLessThan),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeNotSupported),
+							"Field":    Equal("auditConfig.rules.syscallRules[0].filters[3].operator"),
+							"BadValue": Equal(rsyslog.AuditFilterOperator("matches")),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.syscallRules[0].filters[3].value"),
+							"BadValue": Equal("EPERM"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.syscallRules[0].filters[4].value"),
+							"BadValue": Equal("rwz"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("auditConfig.rules.syscallRules[1].syscalls"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the exclusion rules are invalid",
+					rsyslog.AuditConfig{
+						Enabled: true,
+						Rules: &rsyslog.AuditRules{
+							FileWatches: []rsyslog.AuditFileWatch{{Path: "/etc/passwd", Permissions: "wa"}},
+							ExclusionRules: []rsyslog.AuditExclusionRule{
+								{},
+								{Filters: []rsyslog.AuditFieldFilter{{Field: "euid", Value: "0"}, {Field: "msgtype", Value: "cwd"}}},
+							},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("auditConfig.rules.exclusionRules[0].filters"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeNotSupported),
+							"Field":    Equal("auditConfig.rules.exclusionRules[1].filters[0].field"),
+							"BadValue": Equal("euid"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditConfig.rules.exclusionRules[1].filters[1].value"),
+							"BadValue": Equal("cwd"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Audit Stream Configuration",
+				func(auditStream rsyslog.AuditStream, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: loggingRules,
+						AuditStream:  &auditStream,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when the audit stream settings are correct",
+					rsyslog.AuditStream{Enabled: true, Port: ptr.To(10251), Queue: &rsyslog.AuditStreamQueue{Size: ptr.To(200000), MaxDiskSpace: ptr.To("1g")}},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when the audit stream settings are invalid",
+					rsyslog.AuditStream{Enabled: true, Port: ptr.To(-1), Queue: &rsyslog.AuditStreamQueue{Size: ptr.To(0), MaxDiskSpace: ptr.To("1 GB")}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditStream.port"),
+							"BadValue": Equal(-1),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditStream.queue.size"),
+							"BadValue": Equal(0),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditStream.queue.maxDiskSpace"),
+							"BadValue": Equal("1 GB"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the disk space of the audit stream queue is not limited",
+					rsyslog.AuditStream{Enabled: true, Queue: &rsyslog.AuditStreamQueue{MaxDiskSpace: ptr.To("")}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditStream.queue.maxDiskSpace"),
+							"BadValue": Equal(""),
+							"Detail":   Equal("maxDiskSpace must be greater than 0, as the disk space of the audit stream queue has to be limited"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the disk space of the audit stream queue is zero",
+					rsyslog.AuditStream{Enabled: true, Queue: &rsyslog.AuditStreamQueue{MaxDiskSpace: ptr.To("0m")}},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("auditStream.queue.maxDiskSpace"),
+							"BadValue": Equal("0m"),
+							"Detail":   Equal("maxDiskSpace must be greater than 0, as the disk space of the audit stream queue has to be limited"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Redaction Configuration",
+				func(redaction []rsyslog.RedactionRule, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: loggingRules,
+						Redaction:    redaction,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when the redaction rules are correct",
+					[]rsyslog.RedactionRule{
+						{Preset: ptr.To(rsyslog.RedactionPresetJWT)},
+						{Preset: ptr.To(rsyslog.RedactionPresetEmail), Replacement: ptr.To("<email>")},
+						{Pattern: ptr.To(`password=[^ ]+`), Replacement: ptr.To("password=***")},
+						{Pattern: ptr.To(`api\.key=[[:alnum:]]{8,}`)},
+						{Pattern: ptr.To(`[]a-]+\*\{2\}`)},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when the redaction patterns use syntax which rsyslog and jq interpret differently",
+					[]rsyslog.RedactionRule{
+						{Pattern: ptr.To(`token\n`)},
+						{Pattern: ptr.To(`token=[\.a-z]+`)},
+						{Pattern: ptr.To(`token=[a[b]]+`)},
+						{Pattern: ptr.To(`token=.+?`)},
+						{Pattern: ptr.To(`token=a{,3}`)},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("redaction[0].pattern"),
+							"Detail": Equal(`backslashes may only escape one of the characters .[]()*+?{}|^$\`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("redaction[1].pattern"),
+							"Detail": Equal("bracket expressions must not contain backslashes"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("redaction[2].pattern"),
+							"Detail": Equal("bracket expressions must not contain '[' except in character classes like [:alpha:] or '&&'"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("redaction[3].pattern"),
+							"Detail": Equal("repetition operators must not follow each other"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("redaction[4].pattern"),
+							"Detail": Equal("braces must either enclose a repetition count like {n}, {n,} or {n,m} or be escaped"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when neither or both of preset and pattern are set",
+					[]rsyslog.RedactionRule{
+						{Replacement: ptr.To("***")},
+						{Preset: ptr.To(rsyslog.RedactionPresetAWSAccessKey), Pattern: ptr.To("AKIA[A-Z0-9]{16}")},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("redaction[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("redaction[1]"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when the redaction rules are invalid",
+					[]rsyslog.RedactionRule{
+						{Preset: ptr.To(rsyslog.RedactionPreset("creditCard"))},
+						{Pattern: ptr.To(`token=(`)},
+						{Pattern: ptr.To(`[0-9]*`)},
+						{Pattern: ptr.To(`secret`), Replacement: ptr.To("foo\nbar")},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeNotSupported),
+							"Field":    Equal("redaction[0].preset"),
+							"BadValue": Equal(rsyslog.RedactionPreset("creditCard")),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("redaction[1].pattern"),
+							"BadValue": Equal(`token=(`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("redaction[2].pattern"),
+							"BadValue": Equal(`[0-9]*`),
+							"Detail":   Equal("pattern must not match the empty string"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("redaction[3].replacement"),
+							"BadValue": Equal("foo\nbar"),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Protocol Configuration",
+				func(config rsyslog.RsyslogRelpConfig, matcher gomegatypes.GomegaMatcher) {
+					config.Target = relpTarget
+					config.Port = relpTargetPort
+					config.LoggingRules = loggingRules
+					errorList := validation.ValidateRsyslogRelpConfig(&config, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when the protocols are valid",
+					rsyslog.RsyslogRelpConfig{
+						Protocol:       ptr.To(rsyslog.ProtocolRELP),
+						RebindInterval: ptr.To(100),
+						Timeout:        ptr.To(10),
+						TLS:            &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("rsyslog-tls")},
+						FailoverTarget: &rsyslog.FailoverTarget{Target: "failover.server", Port: 6514, Protocol: ptr.To(rsyslog.ProtocolTCP), ResumeRetryCount: ptr.To(3)},
+						AdditionalTargets: []rsyslog.RelpTarget{
+							{Name: "siem", Target: "siem.server", Port: 6514, Protocol: ptr.To(rsyslog.ProtocolTCP), LoggingRules: loggingRules},
+						},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when the protocol is invalid",
+					rsyslog.RsyslogRelpConfig{
+						Protocol: ptr.To(rsyslog.Protocol("udp")),
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeNotSupported),
+							"Field":  Equal("protocol"),
+							"Detail": Equal(`supported values: "http", "otlp", "relp", "tcp"`),
+						})),
+					),
+				),
+
+				Entry("should forbid config when relp options are set for targets using tcp",
+					rsyslog.RsyslogRelpConfig{
+						Protocol:       ptr.To(rsyslog.ProtocolTCP),
+						RebindInterval: ptr.To(100),
+						Timeout:        ptr.To(10),
+						TLS:            &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("rsyslog-tls"), AuthMode: ptr.To(rsyslog.AuthModeFingerPrint)},
+						FailoverTarget: &rsyslog.FailoverTarget{Target: "failover.server", Port: 6514, Protocol: ptr.To(rsyslog.ProtocolTCP), Timeout: ptr.To(10)},
+						AdditionalTargets: []rsyslog.RelpTarget{
+							{Name: "siem", Target: "siem.server", Port: 6514, Protocol: ptr.To(rsyslog.ProtocolTCP), RebindInterval: ptr.To(100), LoggingRules: loggingRules},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("rebindInterval"),
+							"Detail": Equal(`can only be set if the protocol is "relp"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("timeout"),
+							"Detail": Equal(`cannot be set if the protocol is "tcp"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("tls.authMode"),
+							"Detail": Equal(`authMode "fingerprint" can only be set if the protocol is "relp"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("failoverTarget.timeout"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("additionalTargets[0].rebindInterval"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when tls options are set for targets using tcp without tls",
+					rsyslog.RsyslogRelpConfig{
+						Protocol: ptr.To(rsyslog.ProtocolTCP),
+						TLS:      &rsyslog.TLS{Enabled: false, AuthMode: ptr.To(rsyslog.AuthModeName), PermittedPeer: []string{"rsyslog-server.foo"}},
+						AdditionalTargets: []rsyslog.RelpTarget{
+							{Name: "siem", Target: "siem.server", Port: 6514, Protocol: ptr.To(rsyslog.ProtocolTCP), TLS: &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("rsyslog-tls"), AuthMode: ptr.To(rsyslog.AuthModeName), PermittedPeer: []string{"siem.server"}}, LoggingRules: loggingRules},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("tls.authMode"),
+							"Detail": Equal(`can only be set if tls is enabled when the protocol is "tcp"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("tls.permittedPeer"),
+							"Detail": Equal(`can only be set if tls is enabled when the protocol is "tcp"`),
+						})),
+					),
+				),
+
+				Entry("should allow config when a target uses http",
+					rsyslog.RsyslogRelpConfig{
+						Protocol:     ptr.To(rsyslog.ProtocolHTTP),
+						OutputFormat: ptr.To(rsyslog.OutputFormatJSON),
+						HTTP: &rsyslog.HTTP{
+							Path:                     ptr.To("services/collector/raw?channel=0"),
+							TokenSecretReferenceName: ptr.To("splunk-token"),
+							Batch: &rsyslog.HTTPBatch{
+								Format:   ptr.To(rsyslog.HTTPBatchFormatJSONArray),
+								MaxSize:  ptr.To(100),
+								MaxBytes: ptr.To(1048576),
+							},
+						},
+						TLS:              &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("rsyslog-tls")},
+						Timeout:          ptr.To(10),
+						ResumeRetryCount: ptr.To(-1),
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when the http options are invalid",
+					rsyslog.RsyslogRelpConfig{
+						Protocol: ptr.To(rsyslog.ProtocolHTTP),
+						HTTP: &rsyslog.HTTP{
+							Path:                     ptr.To("/services/collector"),
+							TokenSecretReferenceName: ptr.To(""),
+							Batch: &rsyslog.HTTPBatch{
+								Format:   ptr.To(rsyslog.HTTPBatchFormat("lokirest")),
+								MaxSize:  ptr.To(0),
+								MaxBytes: ptr.To(-1),
+							},
+						},
+						TLS: &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("rsyslog-tls"), AuthMode: ptr.To(rsyslog.AuthModeName), PermittedPeer: []string{"rsyslog-server.foo"}, TLSLib: ptr.To(rsyslog.TLSLib(rsyslog.TLSLibOpenSSL))},
+						AdditionalTargets: []rsyslog.RelpTarget{
+							{Name: "siem", Target: "siem.server", Port: 443, HTTP: &rsyslog.HTTP{Path: ptr.To("ingest")}, LoggingRules: loggingRules},
+						},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("http.path"),
+							"Detail": Equal("path must be a non-empty url path without a leading slash"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("http.tokenSecretReferenceName"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeNotSupported),
+							"Field":  Equal("http.batch.format"),
+							"Detail": Equal(`supported values: "jsonarray", "newline"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("http.batch.maxSize"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("http.batch.maxBytes"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("tls.authMode"),
+							"Detail": Equal(`cannot be set if the protocol is "http"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("tls.permittedPeer"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("tls.tlsLib"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("additionalTargets[0].http"),
+							"Detail": Equal(`can only be set if the protocol is "http" or "otlp"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("outputFormat"),
+							"Detail": Equal(`outputFormat must be "json" if the protocol of a target server is "http"`),
+						})),
+					),
+				),
+
+				Entry("should allow config when a target uses otlp",
+					rsyslog.RsyslogRelpConfig{
+						Protocol: ptr.To(rsyslog.ProtocolOTLP),
+						HTTP:     &rsyslog.HTTP{TokenSecretReferenceName: ptr.To("otlp-token")},
+						TLS:      &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("rsyslog-tls")},
+						AdditionalTargets: []rsyslog.RelpTarget{
+							{Name: "otel", Target: "otel.server", Port: 4318, Protocol: ptr.To(rsyslog.ProtocolOTLP), LoggingRules: loggingRules},
+						},
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when otlp targets set batching or relp options",
+					rsyslog.RsyslogRelpConfig{
+						Protocol:       ptr.To(rsyslog.ProtocolOTLP),
+						RebindInterval: ptr.To(100),
+						HTTP:           &rsyslog.HTTP{Batch: &rsyslog.HTTPBatch{MaxSize: ptr.To(100)}},
+						TLS:            &rsyslog.TLS{Enabled: true, SecretReferenceName: ptr.To("rsyslog-tls"), AuthMode: ptr.To(rsyslog.AuthModeName)},
+					},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("http.batch"),
+							"Detail": Equal(`cannot be set if the protocol is "otlp"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("rebindInterval"),
+							"Detail": Equal(`can only be set if the protocol is "relp"`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeForbidden),
+							"Field":  Equal("tls.authMode"),
+							"Detail": Equal(`cannot be set if the protocol is "otlp"`),
+						})),
+					),
+				),
+			)
+
+			DescribeTable("Queue Configuration",
+				func(queue rsyslog.Queue, matcher gomegatypes.GomegaMatcher) {
+					rsyslogRelpConfig := &rsyslog.RsyslogRelpConfig{
+						Target:       relpTarget,
+						Port:         relpTargetPort,
+						LoggingRules: loggingRules,
+						Queue:        &queue,
+					}
+					errorList := validation.ValidateRsyslogRelpConfig(rsyslogRelpConfig, path)
+					Expect(errorList).To(matcher)
+				},
+
+				Entry("should allow config when queue settings are correct",
+					rsyslog.Queue{
+						Size:             ptr.To(200000),
+						MaxDiskSpace:     ptr.To("2g"),
+						HighWatermark:    ptr.To(180000),
+						LowWatermark:     ptr.To(140000),
+						DiscardMark:      ptr.To(195000),
+						DiscardSeverity:  ptr.To(6),
+						DequeueBatchSize: ptr.To(1024),
+						WorkerThreads:    ptr.To(2),
+					},
+					BeEmpty(),
+				),
+
+				Entry("should forbid config when maxDiskSpace is invalid",
+					rsyslog.Queue{Size: ptr.To(100000), MaxDiskSpace: ptr.To("48 MiB")},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("queue.maxDiskSpace"),
+							"BadValue": Equal("48 MiB"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when sizes and thread counts are not positive",
+					rsyslog.Queue{Size: ptr.To(0), DequeueBatchSize: ptr.To(-1), WorkerThreads: ptr.To(0)},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("queue.size"),
+							"BadValue": Equal(0),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("queue.dequeueBatchSize"),
+							"BadValue": Equal(-1),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("queue.workerThreads"),
+							"BadValue": Equal(0),
+						})),
+					),
+				),
+
+				Entry("should forbid config when watermarks and discard mark exceed the size",
+					rsyslog.Queue{Size: ptr.To(1000), HighWatermark: ptr.To(2000), LowWatermark: ptr.To(1500), DiscardMark: ptr.To(2500)},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("queue.highWatermark"),
+							"BadValue": Equal(2000),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("queue.lowWatermark"),
+							"BadValue": Equal(1500),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("queue.discardMark"),
+							"BadValue": Equal(2500),
+						})),
+					),
+				),
+
+				Entry("should forbid config when watermarks and discard mark are not ordered",
+					rsyslog.Queue{Size: ptr.To(1000), HighWatermark: ptr.To(800), LowWatermark: ptr.To(900), DiscardMark: ptr.To(700)},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("queue.lowWatermark"),
+							"BadValue": Equal(900),
+							"Detail":   Equal("lowWatermark must be less than highWatermark"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("queue.discardMark"),
+							"BadValue": Equal(700),
+							"Detail":   Equal("discardMark must not be less than highWatermark"),
+						})),
+					),
+				),
+
+				Entry("should forbid config when discardSeverity is out of range",
+					rsyslog.Queue{DiscardSeverity: ptr.To(8)},
+					ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":     Equal(field.ErrorTypeInvalid),
+							"Field":    Equal("queue.discardSeverity"),
+							"BadValue": Equal(8),
+						})),
+					),
+				),
+			)
 		})
 	})
 
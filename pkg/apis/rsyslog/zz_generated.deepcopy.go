@@ -9,6 +9,7 @@
 package rsyslog
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -20,97 +21,836 @@ func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = new(AuditRules)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditConfig.
+func (in *AuditConfig) DeepCopy() *AuditConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditExclusionRule) DeepCopyInto(out *AuditExclusionRule) {
+	*out = *in
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]AuditFieldFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditExclusionRule.
+func (in *AuditExclusionRule) DeepCopy() *AuditExclusionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditExclusionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditFieldFilter) DeepCopyInto(out *AuditFieldFilter) {
+	*out = *in
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(AuditFilterOperator)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditFieldFilter.
+func (in *AuditFieldFilter) DeepCopy() *AuditFieldFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditFieldFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditFileWatch) DeepCopyInto(out *AuditFileWatch) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditFileWatch.
+func (in *AuditFileWatch) DeepCopy() *AuditFileWatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditFileWatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditRules) DeepCopyInto(out *AuditRules) {
+	*out = *in
+	if in.FileWatches != nil {
+		in, out := &in.FileWatches, &out.FileWatches
+		*out = make([]AuditFileWatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SyscallRules != nil {
+		in, out := &in.SyscallRules, &out.SyscallRules
+		*out = make([]AuditSyscallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExclusionRules != nil {
+		in, out := &in.ExclusionRules, &out.ExclusionRules
+		*out = make([]AuditExclusionRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditRules.
+func (in *AuditRules) DeepCopy() *AuditRules {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditStream) DeepCopyInto(out *AuditStream) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(AuditStreamQueue)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditStream.
+func (in *AuditStream) DeepCopy() *AuditStream {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditStream)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditStreamQueue) DeepCopyInto(out *AuditStreamQueue) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxDiskSpace != nil {
+		in, out := &in.MaxDiskSpace, &out.MaxDiskSpace
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditStreamQueue.
+func (in *AuditStreamQueue) DeepCopy() *AuditStreamQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditStreamQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditSyscallRule) DeepCopyInto(out *AuditSyscallRule) {
+	*out = *in
+	if in.Arch != nil {
+		in, out := &in.Arch, &out.Arch
+		*out = new(AuditArch)
+		**out = **in
+	}
+	if in.Syscalls != nil {
+		in, out := &in.Syscalls, &out.Syscalls
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]AuditFieldFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditSyscallRule.
+func (in *AuditSyscallRule) DeepCopy() *AuditSyscallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditSyscallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Auditd) DeepCopyInto(out *Auditd) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Auditd.
+func (in *Auditd) DeepCopy() *Auditd {
+	if in == nil {
+		return nil
+	}
+	out := new(Auditd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Auditd) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	if in.AllOf != nil {
+		in, out := &in.AllOf, &out.AllOf
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AnyOf != nil {
+		in, out := &in.AnyOf, &out.AnyOf
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Not != nil {
+		in, out := &in.Not, &out.Not
+		*out = new(Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(PropertyMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerLogs) DeepCopyInto(out *ContainerLogs) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerLogs.
+func (in *ContainerLogs) DeepCopy() *ContainerLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomField) DeepCopyInto(out *CustomField) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomField.
+func (in *CustomField) DeepCopy() *CustomField {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverTarget) DeepCopyInto(out *FailoverTarget) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(Protocol)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RebindInterval != nil {
+		in, out := &in.RebindInterval, &out.RebindInterval
+		*out = new(int)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.ResumeRetryCount != nil {
+		in, out := &in.ResumeRetryCount, &out.ResumeRetryCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.ReportSuspensionContinuation != nil {
+		in, out := &in.ReportSuspensionContinuation, &out.ReportSuspensionContinuation
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverTarget.
+func (in *FailoverTarget) DeepCopy() *FailoverTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTP) DeepCopyInto(out *HTTP) {
+	*out = *in
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenSecretReferenceName != nil {
+		in, out := &in.TokenSecretReferenceName, &out.TokenSecretReferenceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(HTTPBatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP.
+func (in *HTTP) DeepCopy() *HTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBatch) DeepCopyInto(out *HTTPBatch) {
+	*out = *in
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(HTTPBatchFormat)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBatch.
+func (in *HTTPBatch) DeepCopy() *HTTPBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Input) DeepCopyInto(out *Input) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(InputMode)
+		**out = **in
+	}
+	if in.ReplayJournal != nil {
+		in, out := &in.ReplayJournal, &out.ReplayJournal
+		*out = new(bool)
+		**out = **in
+	}
+	if in.JournalFields != nil {
+		in, out := &in.JournalFields, &out.JournalFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Input.
+func (in *Input) DeepCopy() *Input {
+	if in == nil {
+		return nil
+	}
+	out := new(Input)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelLogs) DeepCopyInto(out *KernelLogs) {
+	*out = *in
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]LoggingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelLogs.
+func (in *KernelLogs) DeepCopy() *KernelLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalCopy) DeepCopyInto(out *LocalCopy) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxFiles != nil {
+		in, out := &in.MaxFiles, &out.MaxFiles
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalCopy.
+func (in *LocalCopy) DeepCopy() *LocalCopy {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalCopy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingRule) DeepCopyInto(out *LoggingRule) {
+	*out = *in
+	if in.ProgramNames != nil {
+		in, out := &in.ProgramNames, &out.ProgramNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SystemdUnits != nil {
+		in, out := &in.SystemdUnits, &out.SystemdUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Facilities != nil {
+		in, out := &in.Facilities, &out.Facilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(int)
+		**out = **in
+	}
+	if in.SeverityRange != nil {
+		in, out := &in.SeverityRange, &out.SeverityRange
+		*out = new(SeverityRange)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageContent != nil {
+		in, out := &in.MessageContent, &out.MessageContent
+		*out = new(MessageContent)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PropertyMatchers != nil {
+		in, out := &in.PropertyMatchers, &out.PropertyMatchers
+		*out = make([]PropertyMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(Condition)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RateLimit)
+		**out = **in
+	}
+	if in.Sampling != nil {
+		in, out := &in.Sampling, &out.Sampling
+		*out = new(Sampling)
+		**out = **in
+	}
+	if in.Transformations != nil {
+		in, out := &in.Transformations, &out.Transformations
+		*out = make([]Transformation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingRule.
+func (in *LoggingRule) DeepCopy() *LoggingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageContent) DeepCopyInto(out *MessageContent) {
+	*out = *in
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(string)
+		**out = **in
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageContent.
+func (in *MessageContent) DeepCopy() *MessageContent {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMetadata) DeepCopyInto(out *NodeMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMetadata.
+func (in *NodeMetadata) DeepCopy() *NodeMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertyMatcher) DeepCopyInto(out *PropertyMatcher) {
+	*out = *in
+	if in.Property != nil {
+		in, out := &in.Property, &out.Property
+		*out = new(MessageProperty)
+		**out = **in
+	}
+	if in.JournalField != nil {
+		in, out := &in.JournalField, &out.JournalField
+		*out = new(string)
+		**out = **in
+	}
+	if in.Operator != nil {
+		in, out := &in.Operator, &out.Operator
+		*out = new(PropertyMatchOperator)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditConfig.
-func (in *AuditConfig) DeepCopy() *AuditConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyMatcher.
+func (in *PropertyMatcher) DeepCopy() *PropertyMatcher {
 	if in == nil {
 		return nil
 	}
-	out := new(AuditConfig)
+	out := new(PropertyMatcher)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Auditd) DeepCopyInto(out *Auditd) {
+func (in *Queue) DeepCopyInto(out *Queue) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxDiskSpace != nil {
+		in, out := &in.MaxDiskSpace, &out.MaxDiskSpace
+		*out = new(string)
+		**out = **in
+	}
+	if in.HighWatermark != nil {
+		in, out := &in.HighWatermark, &out.HighWatermark
+		*out = new(int)
+		**out = **in
+	}
+	if in.LowWatermark != nil {
+		in, out := &in.LowWatermark, &out.LowWatermark
+		*out = new(int)
+		**out = **in
+	}
+	if in.DiscardMark != nil {
+		in, out := &in.DiscardMark, &out.DiscardMark
+		*out = new(int)
+		**out = **in
+	}
+	if in.DiscardSeverity != nil {
+		in, out := &in.DiscardSeverity, &out.DiscardSeverity
+		*out = new(int)
+		**out = **in
+	}
+	if in.DequeueBatchSize != nil {
+		in, out := &in.DequeueBatchSize, &out.DequeueBatchSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.WorkerThreads != nil {
+		in, out := &in.WorkerThreads, &out.WorkerThreads
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Auditd.
-func (in *Auditd) DeepCopy() *Auditd {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Queue.
+func (in *Queue) DeepCopy() *Queue {
 	if in == nil {
 		return nil
 	}
-	out := new(Auditd)
+	out := new(Queue)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Auditd) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimit) DeepCopyInto(out *RateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimit.
+func (in *RateLimit) DeepCopy() *RateLimit {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(RateLimit)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoggingRule) DeepCopyInto(out *LoggingRule) {
+func (in *RedactionRule) DeepCopyInto(out *RedactionRule) {
 	*out = *in
-	if in.ProgramNames != nil {
-		in, out := &in.ProgramNames, &out.ProgramNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Preset != nil {
+		in, out := &in.Preset, &out.Preset
+		*out = new(RedactionPreset)
+		**out = **in
 	}
-	if in.Severity != nil {
-		in, out := &in.Severity, &out.Severity
-		*out = new(int)
+	if in.Pattern != nil {
+		in, out := &in.Pattern, &out.Pattern
+		*out = new(string)
 		**out = **in
 	}
-	if in.MessageContent != nil {
-		in, out := &in.MessageContent, &out.MessageContent
-		*out = new(MessageContent)
-		(*in).DeepCopyInto(*out)
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(string)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingRule.
-func (in *LoggingRule) DeepCopy() *LoggingRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedactionRule.
+func (in *RedactionRule) DeepCopy() *RedactionRule {
 	if in == nil {
 		return nil
 	}
-	out := new(LoggingRule)
+	out := new(RedactionRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MessageContent) DeepCopyInto(out *MessageContent) {
+func (in *RelpTarget) DeepCopyInto(out *RelpTarget) {
 	*out = *in
-	if in.Regex != nil {
-		in, out := &in.Regex, &out.Regex
-		*out = new(string)
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(Protocol)
 		**out = **in
 	}
-	if in.Exclude != nil {
-		in, out := &in.Exclude, &out.Exclude
-		*out = new(string)
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]LoggingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RebindInterval != nil {
+		in, out := &in.RebindInterval, &out.RebindInterval
+		*out = new(int)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int)
+		**out = **in
+	}
+	if in.ResumeRetryCount != nil {
+		in, out := &in.ResumeRetryCount, &out.ResumeRetryCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.ReportSuspensionContinuation != nil {
+		in, out := &in.ReportSuspensionContinuation, &out.ReportSuspensionContinuation
+		*out = new(bool)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageContent.
-func (in *MessageContent) DeepCopy() *MessageContent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RelpTarget.
+func (in *RelpTarget) DeepCopy() *RelpTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(MessageContent)
+	out := new(RelpTarget)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -119,6 +859,16 @@ func (in *MessageContent) DeepCopy() *MessageContent {
 func (in *RsyslogRelpConfig) DeepCopyInto(out *RsyslogRelpConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(Protocol)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTP)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(TLS)
@@ -131,6 +881,13 @@ func (in *RsyslogRelpConfig) DeepCopyInto(out *RsyslogRelpConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExcludeRules != nil {
+		in, out := &in.ExcludeRules, &out.ExcludeRules
+		*out = make([]LoggingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.RebindInterval != nil {
 		in, out := &in.RebindInterval, &out.RebindInterval
 		*out = new(int)
@@ -156,6 +913,84 @@ func (in *RsyslogRelpConfig) DeepCopyInto(out *RsyslogRelpConfig) {
 		*out = new(AuditConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdditionalTargets != nil {
+		in, out := &in.AdditionalTargets, &out.AdditionalTargets
+		*out = make([]RelpTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailoverTarget != nil {
+		in, out := &in.FailoverTarget, &out.FailoverTarget
+		*out = new(FailoverTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(Queue)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutputFormat != nil {
+		in, out := &in.OutputFormat, &out.OutputFormat
+		*out = new(OutputFormat)
+		**out = **in
+	}
+	if in.StaticMetadata != nil {
+		in, out := &in.StaticMetadata, &out.StaticMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeMetadata != nil {
+		in, out := &in.NodeMetadata, &out.NodeMetadata
+		*out = new(NodeMetadata)
+		**out = **in
+	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Input != nil {
+		in, out := &in.Input, &out.Input
+		*out = new(Input)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerLogs != nil {
+		in, out := &in.ContainerLogs, &out.ContainerLogs
+		*out = new(ContainerLogs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KernelLogs != nil {
+		in, out := &in.KernelLogs, &out.KernelLogs
+		*out = new(KernelLogs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vali != nil {
+		in, out := &in.Vali, &out.Vali
+		*out = new(Vali)
+		**out = **in
+	}
+	if in.LocalCopy != nil {
+		in, out := &in.LocalCopy, &out.LocalCopy
+		*out = new(LocalCopy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = make([]RedactionRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AuditStream != nil {
+		in, out := &in.AuditStream, &out.AuditStream
+		*out = new(AuditStream)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -177,6 +1012,48 @@ func (in *RsyslogRelpConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Sampling) DeepCopyInto(out *Sampling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Sampling.
+func (in *Sampling) DeepCopy() *Sampling {
+	if in == nil {
+		return nil
+	}
+	out := new(Sampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeverityRange) DeepCopyInto(out *SeverityRange) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeverityRange.
+func (in *SeverityRange) DeepCopy() *SeverityRange {
+	if in == nil {
+		return nil
+	}
+	out := new(SeverityRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLS) DeepCopyInto(out *TLS) {
 	*out = *in
@@ -212,3 +1089,98 @@ func (in *TLS) DeepCopy() *TLS {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Transformation) DeepCopyInto(out *Transformation) {
+	*out = *in
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyslogTag != nil {
+		in, out := &in.SyslogTag, &out.SyslogTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.AppName != nil {
+		in, out := &in.AppName, &out.AppName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Field != nil {
+		in, out := &in.Field, &out.Field
+		*out = new(CustomField)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Transformation.
+func (in *Transformation) DeepCopy() *Transformation {
+	if in == nil {
+		return nil
+	}
+	out := new(Transformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Vali) DeepCopyInto(out *Vali) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Vali.
+func (in *Vali) DeepCopy() *Vali {
+	if in == nil {
+		return nil
+	}
+	out := new(Vali)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPool) DeepCopyInto(out *WorkerPool) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int)
+		**out = **in
+	}
+	if in.LoggingRules != nil {
+		in, out := &in.LoggingRules, &out.LoggingRules
+		*out = make([]LoggingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AuditConfig != nil {
+		in, out := &in.AuditConfig, &out.AuditConfig
+		*out = new(AuditConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(Queue)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPool.
+func (in *WorkerPool) DeepCopy() *WorkerPool {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPool)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -188,6 +188,10 @@ if [[ -d /host` + constants.RsyslogRelpQueueSpoolDir + ` ]]; then
   rm -rf /host` + constants.RsyslogRelpQueueSpoolDir + `
 fi
 
+if [[ -d /host` + constants.RsyslogLocalCopyDir + ` ]]; then
+  rm -rf /host` + constants.RsyslogLocalCopyDir + `
+fi
+
 if [[ -f /host` + constants.AuditSyslogPluginPath + ` ]]; then
   sed -i "s/^active\\>.*/active = no/i" /host` + constants.AuditSyslogPluginPath + `
 fi
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rsyslogrelppodmetadatareader
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/constants"
+)
+
+const (
+	managedResourceName = "extension-" + constants.ServiceName + "-pod-metadata-reader"
+	clusterRoleName     = "gardener.cloud:extension:" + constants.ServiceName + ":pod-metadata-reader"
+	// tokenExpirationDuration is the validity of the token of the pod metadata reader. The token is delivered to the
+	// nodes with the operating system config, which is only updated when the shoot is reconciled, hence it is valid
+	// long enough to be renewed on the nodes before it expires.
+	tokenExpirationDuration = "720h"
+)
+
+// New creates a new instance of Deployer for the rsyslog relp pod metadata reader. It allows rsyslog on the nodes to
+// read the metadata of pods and namespaces with the token of a dedicated ServiceAccount, so that forwarded container
+// logs can be enriched with the labels of their pods.
+func New(
+	client client.Client,
+	namespace string,
+) component.Deployer {
+	return &rsyslogRelpPodMetadataReader{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+type rsyslogRelpPodMetadataReader struct {
+	client    client.Client
+	namespace string
+}
+
+func (r *rsyslogRelpPodMetadataReader) Deploy(ctx context.Context) error {
+	if err := r.newShootAccessSecret().Reconcile(ctx, r.client); err != nil {
+		return err
+	}
+
+	data, err := r.computeResourcesData()
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForShoot(ctx, r.client, r.namespace, managedResourceName, constants.Origin, false, data)
+}
+
+func (r *rsyslogRelpPodMetadataReader) Destroy(ctx context.Context) error {
+	if err := managedresources.Delete(ctx, r.client, r.namespace, managedResourceName, false); err != nil {
+		return err
+	}
+
+	return kubernetesutils.DeleteObjects(ctx, r.client, r.newShootAccessSecret().Secret)
+}
+
+func (r *rsyslogRelpPodMetadataReader) newShootAccessSecret() *gardenerutils.AccessSecret {
+	return gardenerutils.NewShootAccessSecret(constants.PodMetadataReaderName, r.namespace).
+		WithTokenExpirationDuration(tokenExpirationDuration)
+}
+
+func (r *rsyslogRelpPodMetadataReader) computeResourcesData() (map[string][]byte, error) {
+	clusterRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterRoleName,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods", "namespaces"},
+				Verbs:     []string{"get"},
+			},
+		},
+	}
+
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterRoleName,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole.Name,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      constants.PodMetadataReaderName,
+				Namespace: metav1.NamespaceSystem,
+			},
+		},
+	}
+
+	registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+	return registry.AddAllAndSerialize(clusterRole, clusterRoleBinding)
+}
@@ -12,6 +12,9 @@ const (
 
 	// Origin is the origin used for the shoot-rsyslog-relp ManagedResources.
 	Origin = "shoot-rsyslog-relp"
+	// PodMetadataReaderName is the name of the ServiceAccount in the kube-system namespace of the shoot with which
+	// rsyslog reads the metadata of pods and namespaces for enriching container logs.
+	PodMetadataReaderName = "rsyslog-relp-pod-metadata-reader"
 
 	// RsyslogCertifcateAuthorityKey is a key in a secret's data which holds the certificate authority used for the tls connection.
 	RsyslogCertifcateAuthorityKey = "ca"
@@ -19,6 +22,9 @@ const (
 	RsyslogClientCertificateKey = "crt"
 	// RsyslogPrivateKeyKey is a key in a secret's data which holds the private key used for the tls connection.
 	RsyslogPrivateKeyKey = "key"
+	// RsyslogHTTPTokenKey is a key in a secret's data which holds the value of the Authorization header sent to http
+	// ingestion endpoints.
+	RsyslogHTTPTokenKey = "token"
 
 	// AuditdConfigMapDataKey is a key in a ConfigMap's data which holds the configuration for the auditd service.
 	AuditdConfigMapDataKey = "auditd"
@@ -29,6 +35,14 @@ const (
 	ConfigureRsyslogScriptPath = RsyslogOSCDir + "/configure-rsyslog.sh"
 	// ProcessRsyslogPstatsScriptPath is the path where node-agent will put the rsyslog pstats script from the OSC
 	ProcessRsyslogPstatsScriptPath = RsyslogOSCDir + "/process-rsyslog-pstats.sh"
+	// RotateLocalCopyScriptPath is the path where node-agent will put the script rotating the local copy of the
+	// forwarded log messages from the OSC
+	RotateLocalCopyScriptPath = RsyslogOSCDir + "/rotate-local-copy.sh"
+	// RedactMessagesScriptPath is the path where node-agent will put the script redacting the log messages from the OSC
+	RedactMessagesScriptPath = RsyslogOSCDir + "/redact-messages.sh"
+	// JoinContainerLogLinesScriptPath is the path where node-agent will put the script joining the partial lines of
+	// container logs from the OSC
+	JoinContainerLogLinesScriptPath = RsyslogOSCDir + "/join-container-log-lines.sh"
 	// RsyslogConfigFromOSCPath is the path where node-agent will put rsyslog audit config file from the OSC
 	RsyslogConfigFromOSCPath = RsyslogOSCDir + "/rsyslog.d/60-audit.conf"
 	// RsyslogConfigPath is the path where rsyslog audit config file will be placed
@@ -39,6 +53,13 @@ const (
 	RsyslogTLSDir = "/etc/ssl/rsyslog"
 	// RsyslogRelpQueueSpoolDir is the path for the rsyslog queue spool directory
 	RsyslogRelpQueueSpoolDir = "/var/log/rsyslog"
+	// RsyslogLocalCopyDir is the path where the local copy of the forwarded log messages is kept
+	RsyslogLocalCopyDir = "/var/log/rsyslog-relp"
+	// ValiCredentialsFromOSCPath is the path where node-agent will put the file referring to the credentials of the
+	// logging agent from the OSC
+	ValiCredentialsFromOSCPath = RsyslogOSCDir + "/vali/credentials"
+	// RsyslogValiDir is the path where the credentials with which rsyslog pushes log messages to Vali will be placed
+	RsyslogValiDir = "/etc/ssl/rsyslog-vali"
 
 	// AuditRulesFromOSCDir is the path where node-agent will put the audit rule files from the OSC
 	AuditRulesFromOSCDir = RsyslogOSCDir + "/audit/rules.d"
@@ -21,6 +21,7 @@ import (
 	apisconfig "github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/config"
 	api "github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog"
 	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/component/rsyslogrelpconfigcleaner"
+	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/component/rsyslogrelppodmetadatareader"
 	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/constants"
 )
 
@@ -54,7 +55,42 @@ func (a *actuator) Reconcile(ctx context.Context, _ logr.Logger, ex *extensionsv
 		return fmt.Errorf("failed to decode provider config: %w", err)
 	}
 
-	return deployMonitoringConfig(ctx, a.client, namespace, rsyslogRelpConfig.AuditConfig)
+	if err := deployMonitoringConfig(ctx, a.client, namespace, isAuditEnabled(rsyslogRelpConfig), isAuditStreamEnabled(rsyslogRelpConfig)); err != nil {
+		return err
+	}
+
+	podMetadataReader := rsyslogrelppodmetadatareader.New(a.client, namespace)
+	if rsyslogRelpConfig.ContainerLogs != nil && rsyslogRelpConfig.ContainerLogs.Enabled {
+		if err := podMetadataReader.Deploy(ctx); err != nil {
+			return fmt.Errorf("failed to deploy the rsyslog relp pod metadata reader component: %w", err)
+		}
+		return nil
+	}
+
+	if err := podMetadataReader.Destroy(ctx); err != nil {
+		return fmt.Errorf("failed to destroy the rsyslog relp pod metadata reader component: %w", err)
+	}
+	return nil
+}
+
+// isAuditEnabled returns whether auditing is enabled for the nodes of at least one worker pool.
+func isAuditEnabled(rsyslogRelpConfig *api.RsyslogRelpConfig) bool {
+	if rsyslogRelpConfig.AuditConfig == nil || rsyslogRelpConfig.AuditConfig.Enabled {
+		return true
+	}
+
+	for _, workerPool := range rsyslogRelpConfig.WorkerPools {
+		if workerPool.AuditConfig != nil && workerPool.AuditConfig.Enabled {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAuditStreamEnabled returns whether audit events are sent via a dedicated audit stream.
+func isAuditStreamEnabled(rsyslogRelpConfig *api.RsyslogRelpConfig) bool {
+	return rsyslogRelpConfig.AuditStream != nil && rsyslogRelpConfig.AuditStream.Enabled
 }
 
 // Delete deletes the extension resource.
@@ -74,6 +110,10 @@ func (a *actuator) Delete(ctx context.Context, _ logr.Logger, ex *extensionsv1al
 		return fmt.Errorf("failed cleaning up monitoring configuration: %w", err)
 	}
 
+	if err := rsyslogrelppodmetadatareader.New(a.client, namespace).Destroy(ctx); err != nil {
+		return fmt.Errorf("failed to destroy the rsyslog relp pod metadata reader component: %w", err)
+	}
+
 	return cleanRsyslogRelpConfiguration(ctx, cluster, a.client, namespace)
 }
 
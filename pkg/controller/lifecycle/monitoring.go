@@ -19,7 +19,6 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
-	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog"
 	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/constants"
 )
 
@@ -29,7 +28,7 @@ const (
 	portNameMetrics = "metrics"
 )
 
-func deployMonitoringConfig(ctx context.Context, c client.Client, namespace string, auditConfig *rsyslog.AuditConfig) error {
+func deployMonitoringConfig(ctx context.Context, c client.Client, namespace string, auditEnabled, auditStreamEnabled bool) error {
 	configMapDashboards := emptyConfigMapDashboards(namespace)
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, configMapDashboards, func() error {
 		metav1.SetMetaDataLabel(&configMapDashboards.ObjectMeta, "component", constants.ServiceName)
@@ -73,7 +72,7 @@ func deployMonitoringConfig(ctx context.Context, c client.Client, namespace stri
 		},
 	}
 
-	if auditConfig == nil || auditConfig.Enabled {
+	if auditEnabled {
 		alertingRules = append(alertingRules, monitoringv1.Rule{
 			Alert: "RsyslogRelpAuditRulesNotLoadedSuccessfully",
 			Expr:  intstr.FromString(`absent(rsyslog_augenrules_load_success == 1)`),
@@ -91,6 +90,40 @@ func deployMonitoringConfig(ctx context.Context, c client.Client, namespace stri
 		})
 	}
 
+	if auditStreamEnabled {
+		alertingRules = append(alertingRules,
+			monitoringv1.Rule{
+				Alert: "RsyslogRelpAuditStreamTooManyActionFailures",
+				Expr:  intstr.FromString(`sum(rate(rsyslog_pstat_failed{origin="core.action",name="rsyslog-relp-audit"}[5m])) / sum(rate(rsyslog_pstat_processed{origin="core.action",name="rsyslog-relp-audit"}[5m])) > bool 0.02 == 1`),
+				For:   ptr.To(monitoringv1.Duration("15m")),
+				Labels: map[string]string{
+					"service":    "rsyslog-relp",
+					"severity":   "warning",
+					"type":       "shoot",
+					"visibility": "all",
+				},
+				Annotations: map[string]string{
+					"description": "The cumulative failure rate of the rsyslog relp action sending audit events is greater than 2%. Audit events are kept in the queue of the audit stream until they are sent.",
+					"summary":     "Rsyslog relp has too many failed attempts to send audit events",
+				},
+			},
+			monitoringv1.Rule{
+				Alert: "RsyslogRelpAuditStreamMessagesDiscarded",
+				Expr:  intstr.FromString(`sum(rate(rsyslog_pstat_discarded_full{name="rsyslog-relp-audit queue"}[5m])) + sum(rate(rsyslog_pstat_discarded_nf{name="rsyslog-relp-audit queue"}[5m])) > 0`),
+				Labels: map[string]string{
+					"service":    "rsyslog-relp",
+					"severity":   "critical",
+					"type":       "shoot",
+					"visibility": "all",
+				},
+				Annotations: map[string]string{
+					"description": "The queue of the audit stream discards audit events although it blocks new audit events while it is full. The rsyslog service and the connection to the target server on the nodes should be checked.",
+					"summary":     "Rsyslog relp discards audit events",
+				},
+			},
+		)
+	}
+
 	prometheusRule := emptyPrometheusRule(namespace)
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c, prometheusRule, func() error {
 		metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "component", constants.ServiceName)
@@ -8,6 +8,7 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"strings"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
@@ -29,8 +30,27 @@ const (
 	privilegeEscalationRulesPath = "/var/lib/rsyslog-relp-configurator/audit/rules.d/10-privilege-escalation.rules"
 	privilegeSpecialRulesPath    = "/var/lib/rsyslog-relp-configurator/audit/rules.d/11-privileged-special.rules"
 	systemIntegrityRulesPath     = "/var/lib/rsyslog-relp-configurator/audit/rules.d/12-system-integrity.rules"
+	fileWatchesRulesPath         = "/var/lib/rsyslog-relp-configurator/audit/rules.d/20-file-watches.rules"
+	syscallRulesPath             = "/var/lib/rsyslog-relp-configurator/audit/rules.d/30-syscall-rules.rules"
+	exclusionRulesPath           = "/var/lib/rsyslog-relp-configurator/audit/rules.d/40-exclusion-rules.rules"
+
+	// auditRulesHeader is the header of the audit rules files rendered from the structured audit rules.
+	auditRulesHeader = `## This file is managed by the shoot-rsyslog-relp extension
+## The original file was moved to /etc/audit/rules.d.original
+
+`
 )
 
+// auditFilterOperators maps the operators of the audit field filters to the operators of auditctl.
+var auditFilterOperators = map[rsyslog.AuditFilterOperator]string{
+	rsyslog.AuditFilterOperatorEquals:             "=",
+	rsyslog.AuditFilterOperatorNotEquals:          "!=",
+	rsyslog.AuditFilterOperatorLessThan:           "<",
+	rsyslog.AuditFilterOperatorLessThanOrEqual:    "<=",
+	rsyslog.AuditFilterOperatorGreaterThan:        ">",
+	rsyslog.AuditFilterOperatorGreaterThanOrEqual: ">=",
+}
+
 var (
 	//go:embed resources/auditrules/00-base-config.rules
 	baseConfigRules []byte
@@ -47,6 +67,10 @@ func getAuditFiles(ctx context.Context, c client.Client, decoder runtime.Decoder
 		return getAuditConfigFromConfigMap(ctx, c, decoder, cluster, namespace, *rsyslogRelpConfig.AuditConfig.ConfigMapReferenceName)
 	}
 
+	if rsyslogRelpConfig.AuditConfig != nil && rsyslogRelpConfig.AuditConfig.Rules != nil {
+		return getStructuredAuditRules(rsyslogRelpConfig.AuditConfig.Rules), nil
+	}
+
 	return getDefaultAuditRules(), nil
 }
 
@@ -133,3 +157,72 @@ func getDefaultAuditRules() []extensionsv1alpha1.File {
 		},
 	}
 }
+
+// getStructuredAuditRules renders the structured audit rules into audit rules files. The base configuration of the
+// default audit rules is kept, as it sets up the buffers and failure mode of the audit system.
+func getStructuredAuditRules(rules *rsyslog.AuditRules) []extensionsv1alpha1.File {
+	files := []extensionsv1alpha1.File{getDefaultAuditRules()[0]}
+
+	var fileWatches []string
+	for _, fileWatch := range rules.FileWatches {
+		fileWatches = append(fileWatches, fmt.Sprintf("-w %s -p %s", fileWatch.Path, fileWatch.Permissions)+computeAuditKey(fileWatch.Key))
+	}
+
+	var syscallRules []string
+	for _, syscallRule := range rules.SyscallRules {
+		rule := fmt.Sprintf("-a exit,always -F arch=%s", ptr.Deref(syscallRule.Arch, rsyslog.AuditArchB64))
+		for _, syscall := range syscallRule.Syscalls {
+			rule += " -S " + syscall
+		}
+		syscallRules = append(syscallRules, rule+computeAuditFieldFilters(syscallRule.Filters)+computeAuditKey(syscallRule.Key))
+	}
+
+	var exclusionRules []string
+	for _, exclusionRule := range rules.ExclusionRules {
+		exclusionRules = append(exclusionRules, "-a exclude,always"+computeAuditFieldFilters(exclusionRule.Filters))
+	}
+
+	for _, rulesFile := range []struct {
+		path  string
+		rules []string
+	}{
+		{fileWatchesRulesPath, fileWatches},
+		{syscallRulesPath, syscallRules},
+		{exclusionRulesPath, exclusionRules},
+	} {
+		if len(rulesFile.rules) == 0 {
+			continue
+		}
+
+		files = append(files, extensionsv1alpha1.File{
+			Path:        rulesFile.path,
+			Permissions: ptr.To(uint32(0744)),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     gardenerutils.EncodeBase64([]byte(auditRulesHeader + strings.Join(rulesFile.rules, "\n") + "\n")),
+				},
+			},
+		})
+	}
+
+	return files
+}
+
+// computeAuditFieldFilters returns the auditctl field filters for the passed filters. The fields and values are
+// validated, so that they do not need to be quoted.
+func computeAuditFieldFilters(filters []rsyslog.AuditFieldFilter) string {
+	var fieldFilters string
+	for _, filter := range filters {
+		operator := auditFilterOperators[ptr.Deref(filter.Operator, rsyslog.AuditFilterOperatorEquals)]
+		fieldFilters += fmt.Sprintf(" -F %s%s%s", filter.Field, operator, filter.Value)
+	}
+	return fieldFilters
+}
+
+func computeAuditKey(key *string) string {
+	if key == nil {
+		return ""
+	}
+	return " -k " + *key
+}
@@ -12,6 +12,7 @@ import (
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	gcontext "github.com/gardener/gardener/extensions/pkg/webhook/context"
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/genericmutator"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -70,7 +71,20 @@ func (e *ensurer) EnsureAdditionalFiles(ctx context.Context, gctx gcontext.Garde
 		}
 	}
 
-	rsyslogFiles, err := getRsyslogFiles(shootRsyslogRelpConfig, cluster)
+	var workerPoolName string
+	if osc := operatingSystemConfigFromContext(ctx); osc != nil {
+		workerPoolName = osc.Labels[v1beta1constants.LabelWorkerPool]
+	}
+	shootRsyslogRelpConfig = getWorkerPoolConfig(shootRsyslogRelpConfig, workerPoolName)
+
+	var valiPush *valiPushEndpoint
+	if vali := shootRsyslogRelpConfig.Vali; vali != nil && vali.Enabled {
+		if valiPush, err = getValiPushEndpoint(*newFiles); err != nil {
+			e.logger.Info("Not pushing the log messages to Vali", "reason", err.Error(), "shoot", client.ObjectKeyFromObject(cluster.Shoot))
+		}
+	}
+
+	rsyslogFiles, err := getRsyslogFiles(shootRsyslogRelpConfig, cluster, workerPoolName, valiPush)
 	if err != nil {
 		return fmt.Errorf("failed to get rsyslog files: %w", err)
 	}
@@ -91,6 +105,37 @@ func (e *ensurer) EnsureAdditionalFiles(ctx context.Context, gctx gcontext.Garde
 	return nil
 }
 
+// getWorkerPoolConfig returns the rsyslog relp configuration for the nodes of the given worker pool, i.e. the passed
+// configuration with the overrides of the worker pool applied. The connection settings of the primary target server,
+// e.g. its protocol and tls options, are kept, as they cannot be overridden per worker pool.
+func getWorkerPoolConfig(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig, workerPoolName string) *rsyslog.RsyslogRelpConfig {
+	for _, workerPool := range rsyslogRelpConfig.WorkerPools {
+		if workerPool.Name != workerPoolName {
+			continue
+		}
+
+		workerPoolConfig := rsyslogRelpConfig.DeepCopy()
+		if workerPool.Target != nil {
+			workerPoolConfig.Target = *workerPool.Target
+		}
+		if workerPool.Port != nil {
+			workerPoolConfig.Port = *workerPool.Port
+		}
+		if workerPool.LoggingRules != nil {
+			workerPoolConfig.LoggingRules = workerPool.LoggingRules
+		}
+		if workerPool.AuditConfig != nil {
+			workerPoolConfig.AuditConfig = workerPool.AuditConfig
+		}
+		if workerPool.Queue != nil {
+			workerPoolConfig.Queue = workerPool.Queue
+		}
+		return workerPoolConfig
+	}
+
+	return rsyslogRelpConfig
+}
+
 func (e *ensurer) EnsureAdditionalUnits(_ context.Context, _ gcontext.GardenContext, newUnits, _ *[]extensionsv1alpha1.Unit) error {
 	*newUnits = extensionswebhook.EnsureUnitWithName(*newUnits, getRsyslogConfiguratorUnit())
 	return nil
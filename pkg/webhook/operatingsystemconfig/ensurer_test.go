@@ -144,6 +144,44 @@ var _ = Describe("Ensurer", func() {
 			expectedFiles []extensionsv1alpha1.File
 		)
 
+		// createTLSSecret references the secret containing the tls files of the target server in the shoot and
+		// creates it in the shoot namespace.
+		createTLSSecret := func() {
+			shoot.Spec.Resources = append(shoot.Spec.Resources, gardencorev1beta1.NamedResourceReference{
+				Name: "rsyslog-tls",
+				ResourceRef: v1.CrossVersionObjectReference{
+					Kind: "Secret",
+					Name: "rsyslog-tls",
+				},
+			})
+
+			rsyslogSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ref-rsyslog-tls",
+					Namespace: shootTechnicalID,
+				},
+				Data: map[string][]byte{
+					"ca":  []byte("ca"),
+					"crt": []byte("crt"),
+					"key": []byte("key"),
+				},
+			}
+			Expect(fakeClient.Create(ctx, rsyslogSecret)).To(Succeed())
+		}
+
+		// enableTLS enables tls for the target server with the files of the secret created by createTLSSecret.
+		enableTLS := func() {
+			createTLSSecret()
+
+			extensionProviderConfig.TLS = &rsyslog.TLS{
+				Enabled:             true,
+				SecretReferenceName: ptr.To("rsyslog-tls"),
+				AuthMode:            &authModeName,
+				TLSLib:              &tlsLibOpenSSL,
+				PermittedPeer:       []string{"rsyslog-server.foo", "rsyslog-server.foo.bar"},
+			}
+		}
+
 		BeforeEach(func() {
 			ensurer = NewEnsurer(fakeClient, decoder, logger)
 			files = []extensionsv1alpha1.File{oldFile}
@@ -170,52 +208,868 @@ var _ = Describe("Ensurer", func() {
 		})
 
 		Context("when tls is enabled", func() {
+			BeforeEach(func() {
+				enableTLS()
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithTLS(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithTLS(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when the protocol is tcp", func() {
+			BeforeEach(func() {
+				enableTLS()
+				extensionProviderConfig.Protocol = ptr.To(rsyslog.ProtocolTCP)
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithTCP(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithTCP(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when the protocol is http", func() {
+			BeforeEach(func() {
+				createTLSSecret()
+				shoot.Spec.Resources = append(shoot.Spec.Resources, gardencorev1beta1.NamedResourceReference{
+					Name: "rsyslog-token",
+					ResourceRef: v1.CrossVersionObjectReference{
+						Kind: "Secret",
+						Name: "rsyslog-token",
+					},
+				})
+
+				extensionProviderConfig.Protocol = ptr.To(rsyslog.ProtocolHTTP)
+				extensionProviderConfig.OutputFormat = ptr.To(rsyslog.OutputFormatJSON)
+				extensionProviderConfig.Timeout = ptr.To(30)
+				extensionProviderConfig.ResumeRetryCount = ptr.To(-1)
+				extensionProviderConfig.HTTP = &rsyslog.HTTP{
+					Path:                     ptr.To("services/collector/raw"),
+					TokenSecretReferenceName: ptr.To("rsyslog-token"),
+					Batch: &rsyslog.HTTPBatch{
+						Format:  ptr.To(rsyslog.HTTPBatchFormatJSONArray),
+						MaxSize: ptr.To(100),
+					},
+					Retry: ptr.To(true),
+				}
+				extensionProviderConfig.TLS = &rsyslog.TLS{
+					Enabled:             true,
+					SecretReferenceName: ptr.To("rsyslog-tls"),
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithHTTP(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogHTTPTokenFile(true))
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithHTTP(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogHTTPTokenFile(false))
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when the protocol is otlp", func() {
+			BeforeEach(func() {
+				createTLSSecret()
+				shoot.Spec.Resources = append(shoot.Spec.Resources, gardencorev1beta1.NamedResourceReference{
+					Name: "rsyslog-token",
+					ResourceRef: v1.CrossVersionObjectReference{
+						Kind: "Secret",
+						Name: "rsyslog-token",
+					},
+				})
+
+				extensionProviderConfig.Protocol = ptr.To(rsyslog.ProtocolOTLP)
+				extensionProviderConfig.HTTP = &rsyslog.HTTP{
+					TokenSecretReferenceName: ptr.To("rsyslog-token"),
+				}
+				extensionProviderConfig.TLS = &rsyslog.TLS{
+					Enabled:             true,
+					SecretReferenceName: ptr.To("rsyslog-tls"),
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithOTLP(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogHTTPTokenFile(true))
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithOTLP(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogHTTPTokenFile(false))
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when pushing log messages to vali is enabled", func() {
+			BeforeEach(func() {
+				enableTLS()
+				extensionProviderConfig.Vali = &rsyslog.Vali{Enabled: true}
+				files = append(files, webhooktest.GetValitailConfigFile())
+
+				expectedFiles = append(expectedFiles, webhooktest.GetValitailConfigFile())
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithVali(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetValiCredentialsFile())
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithVali(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when pushing log messages to vali is enabled but the logging agent is not configured", func() {
+			BeforeEach(func() {
+				enableTLS()
+				extensionProviderConfig.Vali = &rsyslog.Vali{Enabled: true}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithTLS(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+			})
+
+			It("should not push the log messages to vali", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when audit events are sent via a dedicated audit stream", func() {
+			BeforeEach(func() {
+				enableTLS()
+				extensionProviderConfig.Vali = &rsyslog.Vali{Enabled: true}
+				extensionProviderConfig.AuditStream = &rsyslog.AuditStream{
+					Enabled: true,
+					Port:    ptr.To(10251),
+					Queue:   &rsyslog.AuditStreamQueue{MaxDiskSpace: ptr.To("1g")},
+				}
+				files = append(files, webhooktest.GetValitailConfigFile())
+
+				expectedFiles = append(expectedFiles, webhooktest.GetValitailConfigFile())
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithAuditStream(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetValiCredentialsFile())
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithAuditStream(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when audit events are sent via a dedicated audit stream and exclude rules are configured", func() {
+			BeforeEach(func() {
+				enableTLS()
+				extensionProviderConfig.AuditStream = &rsyslog.AuditStream{Enabled: true}
+				extensionProviderConfig.ExcludeRules = []rsyslog.LoggingRule{
+					{ProgramNames: []string{"audisp-syslog"}, MessageContent: &rsyslog.MessageContent{Regex: ptr.To("type=PROCTITLE")}},
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithAuditStreamAndExcludeRules(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+			})
+
+			It("should send the audit events via the audit stream before the exclude rules are applied", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should limit the disk space of the audit stream queue by default", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+				Expect(string(webhooktest.GetRsyslogConfigWithAuditStreamAndExcludeRules())).To(ContainSubstring("queue.maxDiskSpace=\"48m\"\n    queue.timeoutEnqueue=\"2147483647\""))
+			})
+		})
+
+		Context("when exclude rules are configured", func() {
+			BeforeEach(func() {
+				enableTLS()
+				extensionProviderConfig.ExcludeRules = []rsyslog.LoggingRule{
+					{ProgramNames: []string{"kubelet"}, MessageContent: &rsyslog.MessageContent{Regex: ptr.To("Probe succeeded")}},
+					{PropertyMatchers: []rsyslog.PropertyMatcher{{Property: ptr.To(rsyslog.MessagePropertyMessage), Operator: ptr.To(rsyslog.PropertyMatchOperatorRegex), Value: "GET /healthz"}}},
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithExcludeRules(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithExcludeRules(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when a local copy of the forwarded log messages is kept", func() {
+			BeforeEach(func() {
+				enableTLS()
+				extensionProviderConfig.LocalCopy = &rsyslog.LocalCopy{
+					Enabled:  true,
+					MaxSize:  ptr.To("200m"),
+					MaxFiles: ptr.To(3),
+					MaxAge:   ptr.To(1440),
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithLocalCopy(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRotateLocalCopyScriptFile(true))
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithLocalCopy(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+				files = append(files, webhooktest.GetRotateLocalCopyScriptFile(false))
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when log messages are redacted before they are forwarded", func() {
+			BeforeEach(func() {
+				enableTLS()
+				extensionProviderConfig.Redaction = []rsyslog.RedactionRule{
+					{Preset: ptr.To(rsyslog.RedactionPresetJWT)},
+					{Preset: ptr.To(rsyslog.RedactionPresetEmail), Replacement: ptr.To("<email>")},
+					{Pattern: ptr.To(`password=[^ "]+`), Replacement: ptr.To("password=***")},
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithRedaction(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRedactMessagesScriptFile(true))
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithRedaction(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+				files = append(files, webhooktest.GetRedactMessagesScriptFile(false))
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when logging rules are rate limited or sampled", func() {
+			BeforeEach(func() {
+				enableTLS()
+				extensionProviderConfig.LoggingRules = []rsyslog.LoggingRule{
+					{Severity: ptr.To(7), ProgramNames: []string{"audisp-syslog", "audispd"}},
+					{Severity: ptr.To(7), ProgramNames: []string{"kubelet"}, RateLimit: &rsyslog.RateLimit{Interval: 10, Burst: 100}},
+					{Severity: ptr.To(7), ProgramNames: []string{"containerd"}, RateLimit: &rsyslog.RateLimit{Interval: 1, Burst: 20}, Sampling: &rsyslog.Sampling{OneIn: 10}},
+					{Severity: ptr.To(2)},
+				}
+				extensionProviderConfig.AdditionalTargets = []rsyslog.RelpTarget{
+					{
+						Name:   "app-logs",
+						Target: "app-logs.foo",
+						Port:   10250,
+						LoggingRules: []rsyslog.LoggingRule{
+							{Severity: ptr.To(6), Sampling: &rsyslog.Sampling{OneIn: 100}},
+							{Severity: ptr.To(7)},
+						},
+					},
+				}
+				extensionProviderConfig.KernelLogs = &rsyslog.KernelLogs{
+					Enabled: true,
+					LoggingRules: []rsyslog.LoggingRule{
+						{Severity: ptr.To(7), RateLimit: &rsyslog.RateLimit{Interval: 60, Burst: 1000}},
+					},
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithRateLimits(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithRateLimits(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when logging rules transform the log messages", func() {
+			BeforeEach(func() {
+				enableTLS()
+				extensionProviderConfig.OutputFormat = ptr.To(rsyslog.OutputFormatJSON)
+				extensionProviderConfig.LoggingRules = []rsyslog.LoggingRule{
+					{
+						Severity:     ptr.To(7),
+						ProgramNames: []string{"audisp-syslog", "audispd"},
+						Transformations: []rsyslog.Transformation{
+							{Severity: ptr.To(4)},
+							{SyslogTag: ptr.To("audit:")},
+							{AppName: ptr.To("audit")},
+							{Field: &rsyslog.CustomField{Name: "category", Value: "security"}},
+						},
+					},
+					{
+						Severity:        ptr.To(7),
+						ProgramNames:    []string{"kubelet"},
+						Transformations: []rsyslog.Transformation{{Field: &rsyslog.CustomField{Name: "category", Value: "kubernetes"}}},
+					},
+					{Severity: ptr.To(2)},
+				}
+				extensionProviderConfig.AdditionalTargets = []rsyslog.RelpTarget{
+					{
+						Name:   "app-logs",
+						Target: "app-logs.foo",
+						Port:   10250,
+						LoggingRules: []rsyslog.LoggingRule{
+							{Severity: ptr.To(6), ProgramNames: []string{"app"}, Transformations: []rsyslog.Transformation{{AppName: ptr.To("my-app")}}},
+							{Severity: ptr.To(3)},
+						},
+					},
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithTransformations(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithTransformations(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when additional targets are configured", func() {
+			BeforeEach(func() {
+				shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{
+					{
+						Name: "rsyslog-tls-security",
+						ResourceRef: v1.CrossVersionObjectReference{
+							Kind: "Secret",
+							Name: "rsyslog-tls-security",
+						},
+					},
+				}
+
+				extensionProviderConfig.AdditionalTargets = []rsyslog.RelpTarget{
+					{
+						Name:   "security",
+						Target: "security.example.com",
+						Port:   10251,
+						LoggingRules: []rsyslog.LoggingRule{
+							{ProgramNames: []string{"audisp-syslog"}},
+							{Severity: ptr.To(3)},
+						},
+						TLS: &rsyslog.TLS{
+							Enabled:             true,
+							SecretReferenceName: ptr.To("rsyslog-tls-security"),
+							AuthMode:            &authModeName,
+							TLSLib:              &tlsLibOpenSSL,
+							PermittedPeer:       []string{"security.example.com"},
+						},
+						Timeout: ptr.To(90),
+					},
+					{
+						Name:   "platform",
+						Target: "10.0.0.1",
+						Port:   10252,
+						LoggingRules: []rsyslog.LoggingRule{
+							{ProgramNames: []string{"kubelet", "containerd"}, Severity: ptr.To(6)},
+						},
+						RebindInterval:               ptr.To(1000),
+						ResumeRetryCount:             ptr.To(-1),
+						ReportSuspensionContinuation: ptr.To(true),
+					},
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithAdditionalTargets(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetAdditionalTargetRsyslogTLSFiles("security", "rsyslog-tls-security", true)...)
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should modify already existing rsyslog configuration files", func() {
+				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithAdditionalTargets(), false)...)
+				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
+				files = append(files, webhooktest.GetAdditionalTargetRsyslogTLSFiles("security", "rsyslog-tls-security", false)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when a failover target is configured", func() {
 			BeforeEach(func() {
 				shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{
 					{
-						Name: "rsyslog-tls",
+						Name: "rsyslog-tls-security",
 						ResourceRef: v1.CrossVersionObjectReference{
 							Kind: "Secret",
-							Name: "rsyslog-tls",
+							Name: "rsyslog-tls-security",
 						},
 					},
 				}
 
-				rsyslogSecret := &corev1.Secret{
+				extensionProviderConfig.ResumeRetryCount = ptr.To(3)
+				extensionProviderConfig.FailoverTarget = &rsyslog.FailoverTarget{
+					Target:           "standby.example.com",
+					Port:             10251,
+					ResumeRetryCount: ptr.To(-1),
+					TLS: &rsyslog.TLS{
+						Enabled:             true,
+						SecretReferenceName: ptr.To("rsyslog-tls-security"),
+						AuthMode:            &authModeName,
+						TLSLib:              &tlsLibOpenSSL,
+						PermittedPeer:       []string{"standby.example.com"},
+					},
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithFailoverTarget(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetAdditionalTargetRsyslogTLSFiles("failover", "rsyslog-tls-security", true)...)
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when queue settings are configured", func() {
+			BeforeEach(func() {
+				extensionProviderConfig.Queue = &rsyslog.Queue{
+					Size:             ptr.To(200000),
+					MaxDiskSpace:     ptr.To("2g"),
+					HighWatermark:    ptr.To(180000),
+					LowWatermark:     ptr.To(140000),
+					DiscardMark:      ptr.To(195000),
+					DiscardSeverity:  ptr.To(6),
+					DequeueBatchSize: ptr.To(1024),
+					WorkerThreads:    ptr.To(2),
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithQueue(), true)...)
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when static metadata is configured", func() {
+			BeforeEach(func() {
+				extensionProviderConfig.StaticMetadata = map[string]string{
+					"environment": "prod",
+					"costCenter":  "12345",
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithStaticMetadata(), true)...)
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when node metadata is enabled", func() {
+			var osc *extensionsv1alpha1.OperatingSystemConfig
+
+			BeforeEach(func() {
+				shoot.Spec.Region = "eu-west-1"
+				shoot.Spec.SeedName = ptr.To("aws-eu1")
+				shoot.Spec.Kubernetes.Version = "1.30.5"
+				shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+					{
+						Name:       "worker",
+						Machine:    gardencorev1beta1.Machine{Type: "m5.large"},
+						Zones:      []string{"eu-west-1a", "eu-west-1b"},
+						Kubernetes: &gardencorev1beta1.WorkerKubernetes{Version: ptr.To("1.31.1")},
+					},
+				}
+
+				osc = &extensionsv1alpha1.OperatingSystemConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cloud-config-worker",
+						Namespace: shootTechnicalID,
+						Labels:    map[string]string{"worker.gardener.cloud/pool": "worker"},
+					},
+				}
+
+				extensionProviderConfig.NodeMetadata = &rsyslog.NodeMetadata{Enabled: true}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithNodeMetadata(), true)...)
+			})
+
+			It("should add the metadata of the worker pool of the OperatingSystemConfig", func() {
+				Expect(ensurer.EnsureAdditionalFiles(WithOperatingSystemConfig(ctx, osc), gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when worker pool overrides are configured", func() {
+			var osc *extensionsv1alpha1.OperatingSystemConfig
+
+			BeforeEach(func() {
+				osc = &extensionsv1alpha1.OperatingSystemConfig{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:      "ref-rsyslog-tls",
+						Name:      "cloud-config-pci",
 						Namespace: shootTechnicalID,
+						Labels:    map[string]string{"worker.gardener.cloud/pool": "pci"},
 					},
-					Data: map[string][]byte{
-						"ca":  []byte("ca"),
-						"crt": []byte("crt"),
-						"key": []byte("key"),
+				}
+
+				extensionProviderConfig.WorkerPools = []rsyslog.WorkerPool{
+					{
+						Name:   "pci",
+						Target: ptr.To("pci.example.com"),
+						Port:   ptr.To(10251),
+						LoggingRules: []rsyslog.LoggingRule{
+							{
+								Severity:     ptr.To(6),
+								ProgramNames: []string{"sshd", "audisp-syslog"},
+							},
+						},
+						AuditConfig: &rsyslog.AuditConfig{Enabled: false},
+						Queue: &rsyslog.Queue{
+							Size:         ptr.To(50000),
+							MaxDiskSpace: ptr.To("1g"),
+						},
 					},
 				}
-				Expect(fakeClient.Create(ctx, rsyslogSecret)).To(Succeed())
+			})
 
-				extensionProviderConfig.TLS = &rsyslog.TLS{
-					Enabled:             true,
-					SecretReferenceName: ptr.To("rsyslog-tls"),
-					AuthMode:            &authModeName,
-					TLSLib:              &tlsLibOpenSSL,
-					PermittedPeer:       []string{"rsyslog-server.foo", "rsyslog-server.foo.bar"},
+			It("should apply the overrides of the worker pool of the OperatingSystemConfig", func() {
+				expectedFiles = append([]extensionsv1alpha1.File{oldFile}, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithWorkerPoolOverrides(), true)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(WithOperatingSystemConfig(ctx, osc), gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should not apply the overrides to other worker pools", func() {
+				osc.Labels["worker.gardener.cloud/pool"] = "worker"
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetTestingRsyslogConfig(), true)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(WithOperatingSystemConfig(ctx, osc), gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when the input mode is imjournal", func() {
+			BeforeEach(func() {
+				extensionProviderConfig.Input = &rsyslog.Input{
+					Mode:          ptr.To(rsyslog.InputModeImjournal),
+					ReplayJournal: ptr.To(true),
+					JournalFields: []string{"_SYSTEMD_UNIT", "_BOOT_ID"},
+				}
+				extensionProviderConfig.OutputFormat = ptr.To(rsyslog.OutputFormatRFC5424)
+				extensionProviderConfig.LoggingRules = []rsyslog.LoggingRule{
+					{
+						Severity:     ptr.To(5),
+						SystemdUnits: []string{"kubelet.service", "containerd.service"},
+					},
+					{
+						Severity: ptr.To(2),
+					},
+					{
+						Severity:     ptr.To(4),
+						ProgramNames: []string{"systemd", "kube*", "containerd-shim-*-v?"},
+					},
+					{
+						ProgramNames:  []string{"kubelet"},
+						SeverityRange: &rsyslog.SeverityRange{Min: ptr.To(4), Max: ptr.To(4)},
+					},
+					{
+						SystemdUnits:  []string{"containerd.service"},
+						SeverityRange: &rsyslog.SeverityRange{Min: ptr.To(3), Max: ptr.To(5)},
+					},
+					{
+						PropertyMatchers: []rsyslog.PropertyMatcher{
+							{Property: ptr.To(rsyslog.MessagePropertyFacility), Value: "authpriv"},
+						},
+					},
+					{
+						PropertyMatchers: []rsyslog.PropertyMatcher{
+							{JournalField: ptr.To("_SYSTEMD_UNIT"), Operator: ptr.To(rsyslog.PropertyMatchOperatorStartsWith), Value: "kube"},
+							{Property: ptr.To(rsyslog.MessagePropertyHostname), Operator: ptr.To(rsyslog.PropertyMatchOperatorRegex), Value: "^node-[0-9]+$"},
+						},
+					},
+					{
+						Condition: &rsyslog.Condition{AllOf: []rsyslog.Condition{
+							{AnyOf: []rsyslog.Condition{
+								{Match: &rsyslog.PropertyMatcher{Property: ptr.To(rsyslog.MessagePropertyProgramName), Value: "kubelet"}},
+								{Match: &rsyslog.PropertyMatcher{JournalField: ptr.To("_SYSTEMD_UNIT"), Operator: ptr.To(rsyslog.PropertyMatchOperatorStartsWith), Value: "containerd"}},
+							}},
+							{Not: &rsyslog.Condition{Match: &rsyslog.PropertyMatcher{Property: ptr.To(rsyslog.MessagePropertyMessage), Operator: ptr.To(rsyslog.PropertyMatchOperatorRegex), Value: "healthz"}}},
+							{Severity: ptr.To(6)},
+						}},
+					},
 				}
 
-				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithTLS(), true)...)
-				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogTLSFiles(true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithImjournal(), true)...)
 			})
 
 			It("should add additional files to the current ones", func() {
 				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
 				Expect(files).To(ConsistOf(expectedFiles))
 			})
+		})
 
-			It("should modify already existing rsyslog configuration files", func() {
-				files = append(files, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithTLS(), false)...)
-				files = append(files, webhooktest.GetAuditRulesFiles(false)...)
-				files = append(files, webhooktest.GetRsyslogTLSFiles(false)...)
+		Context("when container logs are enabled", func() {
+			BeforeEach(func() {
+				shoot.Status.AdvertisedAddresses = []gardencorev1beta1.ShootAdvertisedAddress{
+					{Name: "external", URL: "https://api.foo.bar.external.example.com"},
+					{Name: "internal", URL: "https://api.foo.bar.internal.example.com"},
+				}
+				extensionProviderConfig.OutputFormat = ptr.To(rsyslog.OutputFormatRFC5424)
+				extensionProviderConfig.ContainerLogs = &rsyslog.ContainerLogs{
+					Enabled:    true,
+					Namespaces: []string{"kube-system", "default"},
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"app.kubernetes.io/name": "foo"},
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"frontend", "backend"}},
+							{Key: "canary", Operator: metav1.LabelSelectorOpDoesNotExist},
+						},
+					},
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithContainerLogs(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetPodMetadataReaderTokenFile(), webhooktest.GetJoinContainerLogLinesScriptFile(true))
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+
+			It("should return an error if the address of the kube-apiserver is not known", func() {
+				shoot.Status.AdvertisedAddresses = nil
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(MatchError(ContainSubstring("failed to determine the address of the kube-apiserver")))
+			})
+		})
+
+		Context("when container logs are enabled and exclude and redaction rules are configured", func() {
+			BeforeEach(func() {
+				shoot.Status.AdvertisedAddresses = []gardencorev1beta1.ShootAdvertisedAddress{
+					{Name: "internal", URL: "https://api.foo.bar.internal.example.com"},
+				}
+				extensionProviderConfig.ContainerLogs = &rsyslog.ContainerLogs{Enabled: true}
+				extensionProviderConfig.ExcludeRules = []rsyslog.LoggingRule{
+					{ProgramNames: []string{"kubernetes"}, MessageContent: &rsyslog.MessageContent{Regex: ptr.To("^GET /healthz")}},
+				}
+				extensionProviderConfig.Redaction = []rsyslog.RedactionRule{
+					{Preset: ptr.To(rsyslog.RedactionPresetJWT)},
+					{Preset: ptr.To(rsyslog.RedactionPresetEmail), Replacement: ptr.To("<email>")},
+					{Pattern: ptr.To(`password=[^ "]+`), Replacement: ptr.To("password=***")},
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithContainerLogsAndExcludeRules(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetPodMetadataReaderTokenFile(), webhooktest.GetJoinContainerLogLinesScriptFile(true))
+				expectedFiles = append(expectedFiles, webhooktest.GetRedactMessagesScriptFile(true))
+			})
+
+			It("should match the exclude and redaction rules against the message text of the container logs", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		Context("when kernel logs are enabled", func() {
+			BeforeEach(func() {
+				extensionProviderConfig.KernelLogs = &rsyslog.KernelLogs{
+					Enabled: true,
+					LoggingRules: []rsyslog.LoggingRule{
+						{
+							Facilities: []string{"kern"},
+							Severity:   ptr.To(4),
+						},
+						{
+							MessageContent: &rsyslog.MessageContent{
+								Regex: ptr.To(`apparmor="DENIED"`),
+							},
+						},
+					},
+				}
+
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithKernelLogs(), true)...)
+			})
+
+			It("should add additional files to the current ones", func() {
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			})
+		})
+
+		DescribeTable("when an output format is configured",
+			func(outputFormat rsyslog.OutputFormat) {
+				extensionProviderConfig.OutputFormat = &outputFormat
+				Expect(fakeClient.Update(ctx, extensionResource)).To(Succeed())
+				expectedFiles = append(expectedFiles, webhooktest.GetRsyslogFiles(webhooktest.GetRsyslogConfigWithOutputFormat(string(outputFormat)), true)...)
+
+				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
+				Expect(files).To(ConsistOf(expectedFiles))
+			},
+
+			Entry("should render the rfc5424 template", rsyslog.OutputFormatRFC5424),
+			Entry("should render the rfc3164 template", rsyslog.OutputFormatRFC3164),
+			Entry("should render the json template", rsyslog.OutputFormatJSON),
+		)
+
+		Context("when structured audit rules are configured", func() {
+			BeforeEach(func() {
+				extensionProviderConfig.AuditConfig = &rsyslog.AuditConfig{
+					Enabled: true,
+					Rules: &rsyslog.AuditRules{
+						FileWatches: []rsyslog.AuditFileWatch{
+							{Path: "/etc/passwd", Permissions: "wa", Key: ptr.To("identity")},
+							{Path: "/etc/sudoers.d/", Permissions: "rwxa"},
+						},
+						SyscallRules: []rsyslog.AuditSyscallRule{
+							{
+								Syscalls: []string{"execve", "execveat"},
+								Filters: []rsyslog.AuditFieldFilter{
+									{Field: "euid", Value: "0"},
+									{Field: "auid", Operator: ptr.To(rsyslog.AuditFilterOperatorGreaterThanOrEqual), Value: "1000"},
+								},
+								Key: ptr.To("privilege_escalation"),
+							},
+							{
+								Arch:     ptr.To(rsyslog.AuditArchB32),
+								Syscalls: []string{"mount"},
+							},
+						},
+						ExclusionRules: []rsyslog.AuditExclusionRule{
+							{Filters: []rsyslog.AuditFieldFilter{{Field: "msgtype", Value: "CWD"}}},
+						},
+					},
+				}
+
+				header := `## This file is managed by the shoot-rsyslog-relp extension
+## The original file was moved to /etc/audit/rules.d.original
+
+`
+				expectedFiles = append([]extensionsv1alpha1.File{oldFile}, webhooktest.GetRsyslogFiles(webhooktest.GetTestingRsyslogConfig(), true)...)
+				expectedFiles = append(expectedFiles, webhooktest.GetAuditRulesFiles(true)[0])
+				for path, content := range map[string]string{
+					"/var/lib/rsyslog-relp-configurator/audit/rules.d/20-file-watches.rules": `-w /etc/passwd -p wa -k identity
+-w /etc/sudoers.d/ -p rwxa
+`,
+					"/var/lib/rsyslog-relp-configurator/audit/rules.d/30-syscall-rules.rules": `-a exit,always -F arch=b64 -S execve -S execveat -F euid=0 -F auid>=1000 -k privilege_escalation
+-a exit,always -F arch=b32 -S mount
+`,
+					"/var/lib/rsyslog-relp-configurator/audit/rules.d/40-exclusion-rules.rules": `-a exclude,always -F msgtype=CWD
+`,
+				} {
+					expectedFiles = append(expectedFiles, extensionsv1alpha1.File{
+						Path:        path,
+						Permissions: ptr.To(uint32(0744)),
+						Content: extensionsv1alpha1.FileContent{
+							Inline: &extensionsv1alpha1.FileContentInline{
+								Encoding: "b64",
+								Data:     gardenerutils.EncodeBase64([]byte(header + content)),
+							},
+						},
+					})
+				}
+			})
 
+			It("should add the rendered audit rules files instead of the default ones", func() {
 				Expect(ensurer.EnsureAdditionalFiles(ctx, gctx, &files, nil)).To(Succeed())
 				Expect(files).To(ConsistOf(expectedFiles))
 			})
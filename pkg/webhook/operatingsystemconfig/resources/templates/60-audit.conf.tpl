@@ -2,19 +2,98 @@
 #
 # SPDX-License-Identifier: Apache-2.0
 
+{{ if eq .outputFormat "rfc5424" -}}
 template(name="SyslogForwarderTemplate" type="list") {
+  constant(value="<")
+  property(name="{{ .properties.pri }}")
+  constant(value=">1 ")
+  property(name="timestamp" dateFormat="rfc3339")
   constant(value=" ")
-  constant(value="{{ .projectName }}")
+  property(name="hostname")
   constant(value=" ")
-  constant(value="{{ .shootName }}")
+  property(name="{{ .properties.appName }}")
   constant(value=" ")
-  constant(value="{{ .shootUID }}")
+  property(name="procid")
+  constant(value=" ")
+  property(name="msgid")
+  constant(value=" [{{ .structuredDataID }}{{ range .metadata }} {{ .key }}=\"{{ .value }}\"{{ end }}")
+  {{- range .journalFields }}
+  constant(value=" {{ . }}=\"")
+  property(name="$!{{ . }}" format="json")
+  constant(value="\"")
+  {{- end }}
+  {{- range .customFields }}
+  constant(value=" {{ . }}=\"")
+  property(name="$.fields!{{ . }}" format="json")
+  constant(value="\"")
+  {{- end }}
+  {{- if .containerLogs }}
+  property(name="$!kubernetes_metadata")
+  {{- end }}
+  constant(value="] ")
+  property(name="{{ .properties.msg }}")
+}
+{{- else if eq .outputFormat "rfc3164" -}}
+template(name="SyslogForwarderTemplate" type="list") {
+  constant(value="<")
+  property(name="{{ .properties.pri }}")
+  constant(value=">")
+  property(name="timestamp" dateFormat="rfc3164")
   constant(value=" ")
   property(name="hostname")
   constant(value=" ")
-  property(name="pri")
+  property(name="{{ .properties.syslogtag }}" position.from="1" position.to="32")
+  property(name="{{ .properties.msg }}" spifno1stsp="on")
+  property(name="{{ .properties.msg }}")
+}
+{{- else if eq .outputFormat "json" -}}
+template(name="SyslogForwarderTemplate" type="list" option.jsonf="on") {
+  {{- range .metadata }}
+  constant(outname="{{ .key }}" value="{{ .value }}" format="jsonf")
+  {{- end }}
+  {{- range .journalFields }}
+  property(outname="{{ . }}" name="$!{{ . }}" format="jsonf")
+  {{- end }}
+  {{- range .customFields }}
+  property(outname="{{ . }}" name="$.fields!{{ . }}" format="jsonf" onEmpty="skip")
+  {{- end }}
+  {{- if .containerLogs }}
+  property(outname="namespace" name="$!kubernetes!namespace_name" format="jsonf" onEmpty="skip")
+  property(outname="pod" name="$!kubernetes!pod_name" format="jsonf" onEmpty="skip")
+  property(outname="container" name="$!kubernetes!container_name" format="jsonf" onEmpty="skip")
+  property(outname="labels" name="$!kubernetes!labels" format="jsonf" onEmpty="skip")
+  {{- end }}
+  property(outname="hostname" name="hostname" format="jsonf")
+  property(outname="pri" name="{{ .properties.pri }}" format="jsonf")
+  property(outname="syslogtag" name="{{ .properties.syslogtag }}" format="jsonf")
+  property(outname="timestamp" name="timestamp" dateFormat="rfc3339" format="jsonf")
+  property(outname="procid" name="procid" format="jsonf")
+  property(outname="msgid" name="msgid" format="jsonf")
+  property(outname="msg" name="{{ .properties.msg }}" format="jsonf")
+}
+{{- else -}}
+template(name="SyslogForwarderTemplate" type="list") {
+  {{- range .metadata }}
   constant(value=" ")
-  property(name="syslogtag")
+  constant(value="{{ .value }}")
+  {{- end }}
+  {{- range .journalFields }}
+  constant(value=" ")
+  property(name="$!{{ . }}")
+  {{- end }}
+  {{- range .customFields }}
+  constant(value=" ")
+  property(name="$.fields!{{ . }}")
+  {{- end }}
+  {{- if .containerLogs }}
+  property(name="$!kubernetes_metadata")
+  {{- end }}
+  constant(value=" ")
+  property(name="hostname")
+  constant(value=" ")
+  property(name="{{ .properties.pri }}")
+  constant(value=" ")
+  property(name="{{ .properties.syslogtag }}")
   constant(value=" ")
   property(name="timestamp" dateFormat="rfc3339")
   constant(value=" ")
@@ -22,17 +101,103 @@ template(name="SyslogForwarderTemplate" type="list") {
   constant(value=" ")
   property(name="msgid")
   constant(value=" ")
-  property(name="msg")
+  property(name="{{ .properties.msg }}")
   constant(value=" ")
 }
+{{- end }}
+{{- if .otlpOutput }}
+
+template(name="OTLPForwarderTemplate" type="list") {
+  constant(value="{\"resourceLogs\":[{\"resource\":{\"attributes\":[")
+  {{- range .otlpResourceAttributes }}
+  constant(value="{{ . }},")
+  {{- end }}
+  constant(value="{\"key\":\"host.name\",\"value\":{\"stringValue\":\"")
+  property(name="hostname" format="json")
+  constant(value="\"}}]},\"scopeLogs\":[{\"logRecords\":[{\"timeUnixNano\":\"")
+  property(name="timereported" dateFormat="unixtimestamp")
+  constant(value="000000000\",\"observedTimeUnixNano\":\"")
+  property(name="timegenerated" dateFormat="unixtimestamp")
+  constant(value="000000000\",\"severityNumber\":")
+  property(name="$.otlp_severity_number")
+  constant(value=",\"severityText\":\"")
+  property(name="{{ .properties.severityText }}" caseConversion="upper")
+  constant(value="\",\"body\":{\"stringValue\":\"")
+  property(name="{{ .properties.msg }}" format="json")
+  constant(value="\"},\"attributes\":[{\"key\":\"syslog.facility\",\"value\":{\"stringValue\":\"")
+  property(name="syslogfacility-text" format="json")
+  constant(value="\"}},{\"key\":\"syslog.appname\",\"value\":{\"stringValue\":\"")
+  property(name="{{ .properties.appName }}" format="json")
+  constant(value="\"}},{\"key\":\"syslog.procid\",\"value\":{\"stringValue\":\"")
+  property(name="procid" format="json")
+  constant(value="\"}},{\"key\":\"syslog.msgid\",\"value\":{\"stringValue\":\"")
+  property(name="msgid" format="json")
+  constant(value="\"}}")
+  {{- range .journalFields }}
+  constant(value=",{\"key\":\"{{ . }}\",\"value\":{\"stringValue\":\"")
+  property(name="$!{{ . }}" format="json")
+  constant(value="\"}}")
+  {{- end }}
+  {{- range .customFields }}
+  constant(value=",{\"key\":\"{{ . }}\",\"value\":{\"stringValue\":\"")
+  property(name="$.fields!{{ . }}" format="json")
+  constant(value="\"}}")
+  {{- end }}
+  {{- if .containerLogs }}
+  constant(value=",{\"key\":\"k8s.namespace.name\",\"value\":{\"stringValue\":\"")
+  property(name="$!kubernetes!namespace_name" format="json")
+  constant(value="\"}},{\"key\":\"k8s.pod.name\",\"value\":{\"stringValue\":\"")
+  property(name="$!kubernetes!pod_name" format="json")
+  constant(value="\"}},{\"key\":\"k8s.container.name\",\"value\":{\"stringValue\":\"")
+  property(name="$!kubernetes!container_name" format="json")
+  constant(value="\"}}")
+  {{- end }}
+  constant(value="]}]}]}]}")
+}
+{{- end }}
+{{- if .httpRetryOutput }}
+
+# The log messages rejected by an http target server are submitted to its retry ruleset as they were sent, hence they
+# are sent again unchanged.
+template(name="HTTPRetryTemplate" type="string" string="%msg%")
+{{- end }}
+{{- with .vali }}
+
+# Each log message is pushed to Vali as a stream of a single entry, whose labels identify the node, program, severity
+# and origin of the log message.
+template(name="ValiForwarderTemplate" type="list") {
+  constant(value="{\"stream\":{\"job\":\"shoot-rsyslog-relp\",\"nodename\":\"")
+  property(name="hostname" format="json")
+  constant(value="\",\"program_name\":\"")
+  property(name="programname" format="json")
+  constant(value="\",\"severity\":\"")
+  property(name="syslogseverity-text" format="json")
+  constant(value="\",\"origin\":\"")
+  property(name="$.vali_origin" format="json")
+  constant(value="\"},\"values\":[[\"")
+  property(name="timegenerated" dateFormat="unixtimestamp")
+  constant(value="000000000\",\"")
+  property(name="{{ $.properties.msg }}" format="json")
+  constant(value="\"]]}")
+}
+{{- end }}
 
 module(
   load="omrelp"
-  {{- if .tls.tlsLib }}
-  tls.tlslib="{{ .tls.tlsLib }}"
+  {{- if .tlsLib }}
+  tls.tlslib="{{ .tlsLib }}"
   {{- end }}
 )
 
+{{- if .httpOutput }}
+
+module(load="omhttp")
+{{- end }}
+{{- if or .redaction .containerLogs }}
+
+module(load="mmexternal")
+{{- end }}
+
 module(load="omprog")
 module(
   load="impstats"
@@ -43,7 +208,15 @@ module(
   bracketing="on"
 )
 
+{{ if eq .inputMode "imjournal" -}}
+module(
+  load="imjournal"
+  StateFile="{{ .imjournalStateFile }}"
+  IgnorePreviousMessages="{{ if .replayJournal }}off{{ else }}on{{ end }}"
+)
+{{- else -}}
 input(type="imuxsock" Socket="/run/systemd/journal/syslog")
+{{- end }}
 
 ruleset(name="process_stats") {
   action(
@@ -53,31 +226,464 @@ ruleset(name="process_stats") {
   )
 }
 
-ruleset(name="relp_action_ruleset") {
+{{ if or .vali .localCopy -}}
+# Vali and the local copy are written by a ruleset shared by the primary target and the audit stream, as the local
+# copy must not be written by several actions.
+ruleset(name="local_outputs") {
+{{- with .vali }}
+  if $programname == ["audisp-syslog","audispd"] then {
+    set $.vali_origin = "audit";
+  } else {
+    set $.vali_origin = "syslog";
+  }
+  # The log messages are buffered in memory while Vali is not reachable. Once the queue is full, further log messages
+  # are not pushed to Vali, so that an unavailable Vali does not delay the target servers.
+  action(
+    name="rsyslog-relp-vali"
+    type="omhttp"
+    server="{{ .server }}"
+    serverport="{{ .port }}"
+    restpath="{{ .path }}"
+    usehttps="on"
+    tls.cacert="{{ .caPath }}"
+    httpheaderkey="Authorization"
+    httpheadervalue=`cat {{ .authorizationPath }}`
+    batch="on"
+    batch.format="lokirest"
+    template="ValiForwarderTemplate"
+    queue.type="linkedlist"
+    queue.size="{{ .queueSize }}"
+    queue.timeoutEnqueue="0"
+    action.resumeRetryCount="-1"
+  )
+{{- end }}
+{{- with .localCopy }}
   action(
-    name="rsyslog-relp"
+    name="rsyslog-relp-local-copy"
+    type="omfile"
+    file="{{ .file }}"
+    template="RSYSLOG_FileFormat"
+    fileCreateMode="0600"
+    dirCreateMode="0700"
+    rotation.sizeLimit="{{ .maxSize }}"
+    rotation.sizeLimitCommand="{{ .rotateScriptPath }}"
+  )
+{{- end }}
+}
+
+{{ end -}}
+{{ template "relp-action-ruleset" . }}
+{{- with .auditStream }}
+
+{{ template "relp-action-ruleset" . }}
+{{- end }}
+{{- range .additionalTargets }}
+
+{{ template "relp-action-ruleset" . }}
+{{- end }}{{ printf "\n" }}
+
+{{- with .containerLogs }}
+module(load="imfile")
+module(load="mmkubernetes")
+
+ruleset(name="container_logs") {
+  {{- if .namespaces }}
+  if not (re_extract($!metadata!filename, "^{{ .podLogsDir }}/([^_]+)_", 0, 1, "") == [{{ .namespaces }}]) then {
+    stop
+  }
+  {{- end }}
+  action(
+    name="kubernetes-metadata"
+    type="mmkubernetes"
+    KubernetesURL="{{ .kubernetesURL }}"
+    tls.cacert="{{ .caPath }}"
+    tokenfile="{{ .tokenPath }}"
+    filenamerules="rule=:{{ .podLogsDir }}/%namespace_name:char-to:_%_%pod_name:char-to:_%_%pod_id:char-to:/%/%container_name:char-to:/%/%-:rest%"
+    de_dot="off"
+  )
+  {{- if .podSelector }}
+  if not ({{ .podSelector }}) then {
+    stop
+  }
+  {{- end }}
+  {{- if .metadata }}
+  set $!kubernetes_metadata = {{ .metadata }};
+  {{- end }}
+  # imfile joins the partial lines of a log message with the final line by newlines, the prefixes of the container
+  # runtime are removed from all lines when they are joined.
+  if re_match($msg, "^[^ ]+ (stdout|stderr) P ") == 1 then {
+    action(
+      name="kubernetes-partial-lines"
+      type="mmexternal"
+      binary="{{ .joinScriptPath }}"
+      interface.input="fulljson"
+    )
+  }
+}
+
+# The container runtime splits long lines written by a container into partial lines, which are followed by the final
+# line of the log message.
+input(
+  type="imfile"
+  File="{{ .podLogsDir }}/*/*/*.log"
+  Tag="kubernetes"
+  addMetadata="on"
+  endmsg.regex="^[^ ]+ (stdout|stderr) F "
+  escapeLF="off"
+)
+
+# The selected container logs are processed like the other log messages once they have been enriched.
+if $inputname == "imfile" then {
+  call container_logs
+}
+
+# The container runtime prefixes each line of the log files with the timestamp, the stream and the partial line flag,
+# which are not part of the forwarded message. The message text without the prefix is matched by the rules.
+ruleset(name="container_log_message") {
+  if $inputname == "imfile" then {
+    set $.msg = re_extract($msg, "^[^ ]+ (stdout|stderr) [FP] (.*)$", 0, 2, $msg);
+  } else {
+    set $.msg = $msg;
+  }
+}
+
+call container_log_message
+{{ end }}
+
+{{- with .redaction }}
+dyn_stats(name="redaction")
+
+ruleset(name="redact_messages") {
+  if {{ .match }} then {
+    set $.redaction_candidates = dyn_inc("redaction", "redaction_candidates");
+    {{- if $.containerLogs }}
+    # The prefix of the container runtime is not redacted, so that it can be removed from the redacted message text.
+    if $inputname == "imfile" then {
+      action(
+        name="kubernetes-redaction"
+        type="mmexternal"
+        binary="{{ .scriptPath }} --container-logs"
+        interface.input="msg"
+      )
+    } else {
+      action(
+        name="rsyslog-relp-redaction"
+        type="mmexternal"
+        binary="{{ .scriptPath }}"
+        interface.input="msg"
+      )
+    }
+    call container_log_message
+    {{- else }}
+    action(
+      name="rsyslog-relp-redaction"
+      type="mmexternal"
+      binary="{{ .scriptPath }}"
+      interface.input="msg"
+    )
+    {{- end }}
+  }
+}
+
+call redact_messages
+{{ end }}
+
+{{- if .properties.transformed }}
+ruleset(name="transformation_defaults") {
+  set $.pri = $pri;
+  set $.severity = $syslogseverity;
+  set $.severity_text = $syslogseverity-text;
+  set $.syslogtag = $syslogtag;
+  set $.app_name = $app-name;
+  {{- if .customFields }}
+  unset $.fields;
+  {{- end }}
+}
+
+call transformation_defaults
+{{ end }}
+
+{{- with .auditStream }}
+# The audit events are sent via the audit stream before the exclude rules are applied, so that they are never dropped.
+if $programname == ["audisp-syslog","audispd"] then {
+  call {{ .rulesetName }}
+}
+{{ end }}
+
+{{- range .excludeFilters }}
+if {{ . }} then {
+  stop
+}
+{{- end }}
+{{- if .excludeFilters }}{{ printf "\n" }}{{ end }}
+
+{{- range .ruleLimits }}
+{{- $name := .name }}
+dyn_stats(name="{{ $name }}")
+
+ruleset(name="{{ $name }}_limits") {
+  set $.limited = 0;
+  {{- with .sampling }}
+  set $/{{ $name }}_sample = ($/{{ $name }}_sample + 1) % {{ .oneIn }};
+  if $/{{ $name }}_sample != 1 then {
+    set $.limited = 1;
+    set $.sampled_out = dyn_inc("{{ $name }}", "sampled_out");
+  }
+  {{- end }}
+  {{- with .rateLimit }}
+  if $.limited == 0 then {
+    set $.window = $now-unixtimestamp / {{ .interval }};
+    if $.window != $/{{ $name }}_window then {
+      set $/{{ $name }}_window = $.window;
+      set $/{{ $name }}_count = 0;
+    }
+    set $/{{ $name }}_count = $/{{ $name }}_count + 1;
+    if $/{{ $name }}_count > {{ .burst }} then {
+      set $.limited = 1;
+      set $.rate_limited = dyn_inc("{{ $name }}", "rate_limited");
+    }
+  }
+  {{- end }}
+}
+{{ end }}
+
+{{- if .additionalTargets }}
+# A log message is sent to an additional target by the first of its rules which matches the log message and whose
+# limits do not drop it, the following rules of the target are still evaluated for log messages dropped by the limits.
+{{- end }}
+{{- range .additionalTargets }}
+{{- $rulesetName := .rulesetName }}
+set $.target_sent = 0;
+{{- range $index, $rule := .rules }}
+if {{ if $index }}$.target_sent == 0 and ({{ $rule.filter }}){{ else }}{{ $rule.filter }}{{ end }} then {
+{{- range $rule.transformations }}
+  {{ . }}
+{{- end }}
+{{- if $rule.limits }}
+  call {{ $rule.limits }}
+  if $.limited == 0 then {
+    call {{ $rulesetName }}
+    set $.target_sent = 1;
+  }
+{{- else }}
+  call {{ $rulesetName }}
+  set $.target_sent = 1;
+{{- end }}
+{{- if $rule.transformations }}
+  call transformation_defaults
+{{- end }}
+}
+{{- end }}
+{{- end }}
+
+{{- with .kernelLogs }}
+{{- if $.additionalTargets }}{{ printf "\n" }}{{ end }}
+{{- if .imklog }}
+module(load="imklog")
+{{- end }}
+dyn_stats(name="kernel")
+
+ruleset(name="kernel_logs") {
+  set $.submitted = dyn_inc("kernel", "submitted");
+  {{- range .rules }}
+  if {{ .filter }} then {
+    {{- if .limits }}
+    call {{ .limits }}
+    if $.limited == 0 then {
+      {{- range .transformations }}
+      {{ . }}
+      {{- end }}
+      call relp_action_ruleset
+      stop
+    }
+    {{- else }}
+    {{- range .transformations }}
+    {{ . }}
+    {{- end }}
+    call relp_action_ruleset
+    stop
+    {{- end }}
+  }
+  {{- else }}
+  call relp_action_ruleset
+  {{- end }}
+}
+
+{{- if .imklog }}
+
+# systemd-journald can be configured to forward the kernel messages to the syslog socket. They are read by imklog,
+# hence the kernel messages received via the socket are dropped, so that they are not forwarded twice.
+if $inputname == "imuxsock" and $syslogfacility == 0 then {
+  stop
+}
+{{- end }}
+
+if {{ .match }} then {
+  call kernel_logs
+  stop
+}
+{{ end }}
+
+{{- if .auditStream }}
+if $programname == ["audisp-syslog","audispd"] then {
+  stop
+}
+{{- end }}
+
+{{- range .rules }}
+if {{ .filter }} then {
+  {{- if .limits }}
+  call {{ .limits }}
+  if $.limited == 0 then {
+    {{- range .transformations }}
+    {{ . }}
+    {{- end }}
+    call relp_action_ruleset
+    stop
+  }
+  {{- else }}
+  {{- range .transformations }}
+  {{ . }}
+  {{- end }}
+  call relp_action_ruleset
+  stop
+  {{- end }}
+}
+{{- end}}
+
+{{- define "relp-action-ruleset" -}}
+{{- with .httpRetry }}
+{{- template "http-retry-ruleset" . }}
+{{- end }}
+{{- with .failover }}
+{{- with .httpRetry }}
+{{- template "http-retry-ruleset" . }}
+{{- end }}
+{{- end }}
+{{- if .rulesetQueueParameters -}}
+# impstats reports the queue of a ruleset under the name of the ruleset, hence the queue is moved to a ruleset named
+# like the queue of the action, so that its statistics keep their name.
+ruleset(name="{{ .rulesetName }}") {
+  call_indirect "{{ .queueRulesetName }}";
+}
+
+ruleset(
+  name="{{ .queueRulesetName }}"
+  {{- range .rulesetQueueParameters }}
+  {{ . }}
+  {{- end }}
+) {
+{{- else -}}
+ruleset(name="{{ .rulesetName }}") {
+{{- end }}
+{{- template "relp-action" . }}
+{{- with .failover }}
+{{- template "relp-action" . }}
+{{- end }}
+{{- if or .vali .localCopy }}
+  call local_outputs
+{{- end }}
+}
+{{- end }}
+
+{{- define "http-retry-ruleset" -}}
+ruleset(name="{{ .rulesetName }}") {
+{{- template "relp-action" . }}
+}
+
+{{ end }}
+
+{{- define "relp-action" }}
+  {{- if and (eq .protocol "otlp") (not .retryAction) }}
+  # Map the syslog severity to the severity number of the OpenTelemetry log data model.
+  set $.otlp_severity_number = field("21,19,18,17,13,10,9,5", 44, {{ .properties.severity }} + 1);
+  {{- end }}
+  action(
+    name="{{ .actionName }}"
+    {{- if eq .protocol "tcp" }}
+    type="omfwd"
+    target="{{ .target }}"
+    port="{{ .port }}"
+    protocol="tcp"
+    TCP_Framing="octet-counted"
+    {{- else if .omhttp }}
+    type="omhttp"
+    server="{{ .target }}"
+    serverport="{{ .port }}"
+    {{- with .http }}
+    {{- if .path }}
+    restpath="{{ .path }}"
+    {{- end }}
+    {{- if .tokenPath }}
+    httpheaderkey="Authorization"
+    httpheadervalue=`cat {{ .tokenPath }}`
+    {{- end }}
+    {{- with .batch }}
+    batch="on"
+    batch.format="{{ .format }}"
+    {{- if .maxSize }}
+    batch.maxsize="{{ .maxSize }}"
+    {{- end }}
+    {{- if .maxBytes }}
+    batch.maxbytes="{{ .maxBytes }}"
+    {{- end }}
+    {{- end }}
+    {{- if .retryRuleset }}
+    retry="on"
+    retry.ruleset="{{ .retryRuleset }}"
+    {{- end }}
+    {{- end }}
+    {{- else }}
     type="omrelp"
     target="{{ .target }}"
     port="{{ .port }}"
-    queue.type="linkedlist"
-    queue.size="100000"
-    queue.filename="rsyslog-relp-queue"
-    queue.saveOnShutdown="on"
-    queue.spoolDirectory="{{ .rsyslogRelpQueueSpoolDir }}"
-    queue.maxDiskSpace="48m"
-    Template="SyslogForwarderTemplate"
+    {{- end }}
+    {{- if .execOnlyWhenPreviousIsSuspended }}
+    action.execOnlyWhenPreviousIsSuspended="on"
+    {{- end }}
+    {{- range .actionQueueParameters }}
+    {{ . }}
+    {{- end }}
+    Template="{{ .template }}"
     {{- if .rebindInterval }}
     rebindInterval="{{ .rebindInterval }}"
     {{- end }}
     {{- if .timeout }}
     timeout="{{ .timeout }}"
     {{- end }}
+    {{- if .restPathTimeout }}
+    restpathtimeout="{{ .restPathTimeout }}"
+    {{- end }}
     {{- if .resumeRetryCount }}
     action.resumeRetryCount="{{ .resumeRetryCount }}"
     {{- end }}
     {{- if .reportSuspensionContinuation }}
     action.reportSuspensionContinuation="{{ .reportSuspensionContinuation }}"
     {{- end }}
+    {{- if eq .protocol "tcp" }}
+    {{- if .tls.enabled }}
+    StreamDriver="{{ .tls.streamDriver }}"
+    StreamDriverMode="1"
+    StreamDriver.CAFile="{{ .tls.caPath }}"
+    StreamDriver.CertFile="{{ .tls.certPath }}"
+    StreamDriver.KeyFile="{{ .tls.keyPath }}"
+    {{- if .tls.streamDriverAuthMode }}
+    StreamDriverAuthMode="{{ .tls.streamDriverAuthMode }}"
+    {{- end }}
+    {{- if .tls.streamDriverPermittedPeers }}
+    StreamDriverPermittedPeers="{{ .tls.streamDriverPermittedPeers }}"
+    {{- end }}
+    {{- end }}
+    {{- else if .omhttp }}
+    {{- if .tls.enabled }}
+    usehttps="on"
+    tls.cacert="{{ .tls.caPath }}"
+    tls.mycert="{{ .tls.certPath }}"
+    tls.myprivkey="{{ .tls.keyPath }}"
+    {{- else }}
+    usehttps="off"
+    {{- end }}
+    {{- else }}
     {{- if .tls.enabled }}
     tls="on"
     tls.caCert="{{ .tls.caPath }}"
@@ -90,12 +696,6 @@ ruleset(name="relp_action_ruleset") {
     {{- if .tls.permittedPeer }}
     tls.permittedpeer=[{{ .tls.permittedPeer }}]
     {{- end }}
+    {{- end }}
   )
-}{{ printf "\n" }}
-
-{{- range .filters }}
-if {{ . }} then {
-  call relp_action_ruleset
-  stop
-}
-{{- end}}
\ No newline at end of file
+{{- end }}
\ No newline at end of file
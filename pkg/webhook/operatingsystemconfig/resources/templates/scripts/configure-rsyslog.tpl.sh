@@ -126,19 +126,53 @@ function configure_rsyslog() {
     restart_rsyslog=true
   fi
 
+  # rsyslog only reads the tls files and the tokens stored next to them on startup, hence it is restarted whenever they
+  # change, e.g. when a referenced secret is replaced.
   if [[ -d {{ .pathRsyslogTLSFromOSCDir }} ]] && [[ -n "$(ls -A "{{ .pathRsyslogTLSFromOSCDir }}" )" ]]; then
     if [[ ! -d {{ .pathRsyslogTLSDir }} ]]; then
       mkdir -p {{ .pathRsyslogTLSDir }}
     fi
     if ! diff -rq {{ .pathRsyslogTLSFromOSCDir }} {{ .pathRsyslogTLSDir }} ; then
       rm -rf {{ .pathRsyslogTLSDir }}/*
-      cp -fL {{ .pathRsyslogTLSFromOSCDir }}/* {{ .pathRsyslogTLSDir }}/
+      cp -rfL {{ .pathRsyslogTLSFromOSCDir }}/* {{ .pathRsyslogTLSDir }}/
       restart_rsyslog=true
     fi
   elif [[ -d {{ .pathRsyslogTLSDir }} ]]; then
     rm -rf {{ .pathRsyslogTLSDir }}
   fi
 
+  # The log messages are pushed to Vali with the credentials of the logging agent of the node. rsyslog only reads them on
+  # startup, hence they are copied and rsyslog is restarted whenever the logging agent rotates them.
+  if [[ -f {{ .pathValiCredentialsFromOSC }} ]]; then
+    # shellcheck source=/dev/null
+    source {{ .pathValiCredentialsFromOSC }}
+    if [[ ! -d {{ .pathRsyslogValiDir }} ]]; then
+      mkdir -p -m 0700 {{ .pathRsyslogValiDir }}
+    fi
+    if [[ -f "${VALI_TOKEN_FILE}" ]] && [[ -f "${VALI_CA_FILE}" ]]; then
+      (umask 077 && printf 'Bearer %s' "$(cat "${VALI_TOKEN_FILE}")" > {{ .pathRsyslogValiDir }}/{{ .valiAuthorizationFileName }}.new)
+      cp -fL "${VALI_CA_FILE}" {{ .pathRsyslogValiDir }}/{{ .valiCACertFileName }}.new
+      for file in {{ .valiAuthorizationFileName }} {{ .valiCACertFileName }}; do
+        if cmp -s "{{ .pathRsyslogValiDir }}/${file}.new" "{{ .pathRsyslogValiDir }}/${file}"; then
+          rm -f "{{ .pathRsyslogValiDir }}/${file}.new"
+        else
+          mv -f "{{ .pathRsyslogValiDir }}/${file}.new" "{{ .pathRsyslogValiDir }}/${file}"
+          restart_rsyslog=true
+        fi
+      done
+    fi
+  elif [[ -d {{ .pathRsyslogValiDir }} ]]; then
+    rm -rf {{ .pathRsyslogValiDir }}
+  fi
+
+  # The local copy of the forwarded log messages is removed once it is disabled. While it is enabled, the rotated files
+  # which exceeded their maximum age are deleted, as the file might not be rotated for a long time.
+  if [[ -f {{ .pathRotateLocalCopyScript }} ]]; then
+    {{ .pathRotateLocalCopyScript }} --delete-expired
+  elif [[ -d {{ .rsyslogLocalCopyDir }} ]]; then
+    rm -rf {{ .rsyslogLocalCopyDir }}
+  fi
+
   if ! systemctl is-active --quiet rsyslog.service ; then
     # Ensure that the rsyslog service is running.
     systemctl start rsyslog.service
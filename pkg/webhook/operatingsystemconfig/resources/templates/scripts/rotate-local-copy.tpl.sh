@@ -0,0 +1,33 @@
+#!/bin/bash
+
+# SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+#
+# SPDX-License-Identifier: Apache-2.0
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+# This script is executed by rsyslog when the local copy of the forwarded log messages reaches its size limit.
+# rsyslog reopens the file after the script has moved it away. The rsyslog configurator executes it periodically with
+# the --delete-expired flag, which only deletes the rotated files exceeding their maximum age.
+file="{{ .file }}"
+{{- if .maxAge }}
+
+find "$(dirname "${file}")" -maxdepth 1 -type f -name "$(basename "${file}").*" -mmin +{{ .maxAge }} -delete
+{{- end }}
+
+if [[ "${1:-}" == "--delete-expired" ]]; then
+  exit 0
+fi
+
+rm -f "${file}.{{ .maxFiles }}"
+for (( i = {{ sub .maxFiles 1 }}; i > 0; i-- )); do
+  if [[ -f "${file}.${i}" ]]; then
+    mv "${file}.${i}" "${file}.$(( i + 1 ))"
+  fi
+done
+
+if [[ -f "${file}" ]]; then
+  mv "${file}" "${file}.1"
+fi
@@ -7,7 +7,13 @@ package operatingsystemconfig
 import (
 	"bytes"
 	_ "embed"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"math"
+	"path"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
@@ -17,7 +23,10 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog"
@@ -26,7 +35,43 @@ import (
 )
 
 const (
+	failoverTargetName = "failover"
+	auditStreamName    = "audit"
+	// httpTokenFileName is the name of the file containing the token for an http ingestion endpoint. It is stored
+	// next to the tls files of the target server.
+	httpTokenFileName = "token"
+	// podMetadataReaderTokenDir is the directory next to the tls files of the target servers which contains the token
+	// with which the metadata of the pods is read for enriching container logs.
+	podMetadataReaderTokenDir = "kubernetes"
+	// structuredDataID is the SD-ID of the structured data element containing the shoot metadata when log messages
+	// are sent in the RFC 5424 format.
+	structuredDataID = "gardener@32473"
+
+	defaultQueueSize         = 100000
+	defaultQueueMaxDiskSpace = "48m"
+	// auditStreamQueueTimeoutEnqueue is the time in milliseconds for which audit events wait for space in the full
+	// queue of the audit stream. rsyslog discards log messages immediately if the timeout is 0, hence the largest
+	// possible timeout is used, so that the audit events are blocked instead of discarded.
+	auditStreamQueueTimeoutEnqueue = math.MaxInt32
+	// defaultOTLPLogsPath is the default path of the endpoint accepting log exports via OTLP/HTTP.
+	defaultOTLPLogsPath = "v1/logs"
+	// localCopyFileName is the name of the file in the local copy directory to which the forwarded log messages are
+	// written.
+	localCopyFileName        = "forwarded.log"
+	defaultLocalCopyMaxSize  = "100m"
+	defaultLocalCopyMaxFiles = 5
+	// kernelLoggingRulePrefix is the prefix of the names of the logging rules for kernel messages.
+	kernelLoggingRulePrefix = "kernel_logging_rule_"
+	// programNameWildcards are the wildcards which can be used in the program names of logging rules.
+	programNameWildcards = "*?"
+	// defaultRedactionReplacement is the text the matches of redaction rules are replaced with if no replacement is
+	// configured.
+	defaultRedactionReplacement = "[REDACTED]"
+
 	rsyslogServiceMemoryLimitsDropInPath = "/etc/systemd/system/rsyslog.service.d/10-shoot-rsyslog-relp-memory-limits.conf"
+	imjournalStateFilePath               = constants.RsyslogRelpQueueSpoolDir + "/imjournal.state"
+	podLogsDir                           = "/var/log/pods"
+	kubeletCACertPath                    = "/var/lib/kubelet/ca.crt"
 	nodeExporterTextfileCollectorDir     = "/var/lib/node-exporter/textfile-collector"
 )
 
@@ -42,6 +87,18 @@ var (
 	//go:embed resources/templates/scripts/process-rsyslog-pstats.tpl.sh
 	processRsyslogPstatsScriptTemplateContent string
 	processRsyslogPstatsScript                bytes.Buffer
+
+	//go:embed resources/templates/scripts/rotate-local-copy.tpl.sh
+	rotateLocalCopyScriptTemplateContent string
+	rotateLocalCopyScriptTemplate        *template.Template
+
+	//go:embed resources/templates/scripts/redact-messages.tpl.sh
+	redactMessagesScriptTemplateContent string
+	redactMessagesScriptTemplate        *template.Template
+
+	//go:embed resources/templates/scripts/join-container-log-lines.tpl.sh
+	joinContainerLogLinesScriptTemplateContent string
+	joinContainerLogLinesScript                bytes.Buffer
 )
 
 func init() {
@@ -74,6 +131,12 @@ func init() {
 		"pathRsyslogAuditConf":             constants.RsyslogConfigPath,
 		"pathRsyslogAuditConfFromOSC":      constants.RsyslogConfigFromOSCPath,
 		"nodeExporterTextfileCollectorDir": nodeExporterTextfileCollectorDir,
+		"pathRotateLocalCopyScript":        constants.RotateLocalCopyScriptPath,
+		"rsyslogLocalCopyDir":              constants.RsyslogLocalCopyDir,
+		"pathValiCredentialsFromOSC":       constants.ValiCredentialsFromOSCPath,
+		"pathRsyslogValiDir":               constants.RsyslogValiDir,
+		"valiAuthorizationFileName":        valiAuthorizationFileName,
+		"valiCACertFileName":               valiCACertFileName,
 	}); err != nil {
 		panic(err)
 	}
@@ -91,22 +154,127 @@ func init() {
 	}); err != nil {
 		panic(err)
 	}
+
+	rotateLocalCopyScriptTemplate, err = template.
+		New("rotate-local-copy.sh").
+		Funcs(sprig.TxtFuncMap()).
+		Parse(rotateLocalCopyScriptTemplateContent)
+	if err != nil {
+		panic(err)
+	}
+
+	redactMessagesScriptTemplate, err = template.
+		New("redact-messages.sh").
+		Funcs(sprig.TxtFuncMap()).
+		Parse(redactMessagesScriptTemplateContent)
+	if err != nil {
+		panic(err)
+	}
+
+	joinContainerLogLinesScriptTemplate, err := template.
+		New("join-container-log-lines.sh").
+		Funcs(sprig.TxtFuncMap()).
+		Parse(joinContainerLogLinesScriptTemplateContent)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := joinContainerLogLinesScriptTemplate.Execute(&joinContainerLogLinesScript, nil); err != nil {
+		panic(err)
+	}
 }
 
-func getRsyslogFiles(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig, cluster *extensionscontroller.Cluster) ([]extensionsv1alpha1.File, error) {
+func getRsyslogFiles(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig, cluster *extensionscontroller.Cluster, workerPoolName string, valiPush *valiPushEndpoint) ([]extensionsv1alpha1.File, error) {
 	var rsyslogFiles []extensionsv1alpha1.File
 
-	rsyslogValues := getRsyslogValues(rsyslogRelpConfig, cluster)
+	rsyslogValues, err := getRsyslogValues(rsyslogRelpConfig, cluster, workerPoolName, valiPush)
+	if err != nil {
+		return nil, err
+	}
 
 	if rsyslogRelpConfig.TLS != nil && rsyslogRelpConfig.TLS.Enabled {
-		rsyslogValues["tls"] = getRsyslogTLSValues(rsyslogRelpConfig)
-		rsyslogTLSFiles, err := getRsyslogTLSFiles(cluster, *rsyslogRelpConfig.TLS.SecretReferenceName)
+		rsyslogTLSFiles, err := getRsyslogTLSFiles(cluster, *rsyslogRelpConfig.TLS.SecretReferenceName, "")
+		if err != nil {
+			return nil, err
+		}
+		rsyslogFiles = append(rsyslogFiles, rsyslogTLSFiles...)
+	}
+
+	if failoverTarget := rsyslogRelpConfig.FailoverTarget; failoverTarget != nil && failoverTarget.TLS != nil && failoverTarget.TLS.Enabled {
+		rsyslogTLSFiles, err := getRsyslogTLSFiles(cluster, *failoverTarget.TLS.SecretReferenceName, failoverTargetName)
 		if err != nil {
 			return nil, err
 		}
 		rsyslogFiles = append(rsyslogFiles, rsyslogTLSFiles...)
 	}
 
+	if rsyslogRelpConfig.HTTP != nil && rsyslogRelpConfig.HTTP.TokenSecretReferenceName != nil {
+		httpTokenFile, err := getHTTPTokenFile(cluster, *rsyslogRelpConfig.HTTP.TokenSecretReferenceName, "")
+		if err != nil {
+			return nil, err
+		}
+		rsyslogFiles = append(rsyslogFiles, httpTokenFile)
+	}
+
+	if failoverTarget := rsyslogRelpConfig.FailoverTarget; failoverTarget != nil && failoverTarget.HTTP != nil && failoverTarget.HTTP.TokenSecretReferenceName != nil {
+		httpTokenFile, err := getHTTPTokenFile(cluster, *failoverTarget.HTTP.TokenSecretReferenceName, failoverTargetName)
+		if err != nil {
+			return nil, err
+		}
+		rsyslogFiles = append(rsyslogFiles, httpTokenFile)
+	}
+
+	for _, additionalTarget := range rsyslogRelpConfig.AdditionalTargets {
+		if additionalTarget.TLS != nil && additionalTarget.TLS.Enabled {
+			rsyslogTLSFiles, err := getRsyslogTLSFiles(cluster, *additionalTarget.TLS.SecretReferenceName, additionalTarget.Name)
+			if err != nil {
+				return nil, err
+			}
+			rsyslogFiles = append(rsyslogFiles, rsyslogTLSFiles...)
+		}
+
+		if additionalTarget.HTTP != nil && additionalTarget.HTTP.TokenSecretReferenceName != nil {
+			httpTokenFile, err := getHTTPTokenFile(cluster, *additionalTarget.HTTP.TokenSecretReferenceName, additionalTarget.Name)
+			if err != nil {
+				return nil, err
+			}
+			rsyslogFiles = append(rsyslogFiles, httpTokenFile)
+		}
+	}
+
+	if containerLogs := rsyslogRelpConfig.ContainerLogs; containerLogs != nil && containerLogs.Enabled {
+		rsyslogFiles = append(rsyslogFiles, getPodMetadataReaderTokenFile(), extensionsv1alpha1.File{
+			Path:        constants.JoinContainerLogLinesScriptPath,
+			Permissions: ptr.To(uint32(0744)),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     gardenerutils.EncodeBase64(joinContainerLogLinesScript.Bytes()),
+				},
+			},
+		})
+	}
+
+	if valiPush != nil {
+		rsyslogFiles = append(rsyslogFiles, getValiCredentialsFile(valiPush))
+	}
+
+	if localCopy := rsyslogRelpConfig.LocalCopy; localCopy != nil && localCopy.Enabled {
+		rotateLocalCopyScriptFile, err := getRotateLocalCopyScriptFile(localCopy)
+		if err != nil {
+			return nil, err
+		}
+		rsyslogFiles = append(rsyslogFiles, rotateLocalCopyScriptFile)
+	}
+
+	if len(rsyslogRelpConfig.Redaction) > 0 {
+		redactMessagesScriptFile, err := getRedactMessagesScriptFile(rsyslogRelpConfig.Redaction)
+		if err != nil {
+			return nil, err
+		}
+		rsyslogFiles = append(rsyslogFiles, redactMessagesScriptFile)
+	}
+
 	var config bytes.Buffer
 	if err := rsyslogAuditConfigTemplate.Execute(&config, rsyslogValues); err != nil {
 		return nil, err
@@ -161,72 +329,609 @@ MemorySwapMax=0`,
 	return rsyslogFiles, nil
 }
 
-func getRsyslogValues(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig, cluster *extensionscontroller.Cluster) map[string]interface{} {
+func getRsyslogValues(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig, cluster *extensionscontroller.Cluster, workerPoolName string, valiPush *valiPushEndpoint) (map[string]interface{}, error) {
 	projectName := utils.ProjectName(cluster.ObjectMeta.Name, cluster.Shoot.Name)
 
+	properties := getMessageProperties(rsyslogRelpConfig)
+
+	// The primary target is rendered in the same way as the additional targets, only its rsyslog relp action
+	// and queue keep their unsuffixed names.
+	rsyslogValues := getRelpTargetValues(rsyslogRelpConfig.Queue, properties, &rsyslog.RelpTarget{
+		Target:                       rsyslogRelpConfig.Target,
+		Port:                         rsyslogRelpConfig.Port,
+		Protocol:                     rsyslogRelpConfig.Protocol,
+		HTTP:                         rsyslogRelpConfig.HTTP,
+		TLS:                          rsyslogRelpConfig.TLS,
+		LoggingRules:                 rsyslogRelpConfig.LoggingRules,
+		RebindInterval:               rsyslogRelpConfig.RebindInterval,
+		Timeout:                      rsyslogRelpConfig.Timeout,
+		ResumeRetryCount:             rsyslogRelpConfig.ResumeRetryCount,
+		ReportSuspensionContinuation: rsyslogRelpConfig.ReportSuspensionContinuation,
+	})
+
+	if failoverTarget := rsyslogRelpConfig.FailoverTarget; failoverTarget != nil {
+		failoverValues := getRelpTargetValues(rsyslogRelpConfig.Queue, properties, &rsyslog.RelpTarget{
+			Name:                         failoverTargetName,
+			Target:                       failoverTarget.Target,
+			Port:                         failoverTarget.Port,
+			Protocol:                     failoverTarget.Protocol,
+			HTTP:                         failoverTarget.HTTP,
+			TLS:                          failoverTarget.TLS,
+			RebindInterval:               failoverTarget.RebindInterval,
+			Timeout:                      failoverTarget.Timeout,
+			ResumeRetryCount:             failoverTarget.ResumeRetryCount,
+			ReportSuspensionContinuation: failoverTarget.ReportSuspensionContinuation,
+		})
+		failoverValues["execOnlyWhenPreviousIsSuspended"] = true
+
+		// The failover action is only executed when the primary action is suspended. This state is not visible
+		// if the primary action has its own queue, hence both actions are executed without action queues and the
+		// queue is moved to a separate ruleset. It is named like the queue of the primary action, so that impstats
+		// still reports the queue as "rsyslog-relp queue".
+		rsyslogValues["rulesetQueueParameters"] = rsyslogValues["actionQueueParameters"]
+		rsyslogValues["queueRulesetName"] = rsyslogValues["actionName"].(string) + " queue"
+		delete(rsyslogValues, "actionQueueParameters")
+		delete(failoverValues, "actionQueueParameters")
+		rsyslogValues["failover"] = failoverValues
+	}
+
+	ruleLimits := computeRuleLimits(rsyslogRelpConfig.LoggingRules, loggingRulePrefix(""))
+
+	var additionalTargets []map[string]interface{}
+	for _, additionalTarget := range rsyslogRelpConfig.AdditionalTargets {
+		additionalTargets = append(additionalTargets, getRelpTargetValues(rsyslogRelpConfig.Queue, properties, &additionalTarget))
+		ruleLimits = append(ruleLimits, computeRuleLimits(additionalTarget.LoggingRules, loggingRulePrefix(additionalTarget.Name))...)
+	}
+
+	protocols := getProtocols(rsyslogRelpConfig)
+
+	input := rsyslogRelpConfig.Input
+	if input == nil {
+		input = &rsyslog.Input{}
+	}
+	rsyslogValues["inputMode"] = string(ptr.Deref(input.Mode, rsyslog.InputModeImuxsock))
+	rsyslogValues["imjournalStateFile"] = imjournalStateFilePath
+	rsyslogValues["replayJournal"] = ptr.Deref(input.ReplayJournal, false)
+	rsyslogValues["journalFields"] = input.JournalFields
+	rsyslogValues["outputFormat"] = string(ptr.Deref(rsyslogRelpConfig.OutputFormat, ""))
+	rsyslogValues["structuredDataID"] = structuredDataID
+	rsyslogValues["metadata"] = getMetadata(rsyslogRelpConfig, projectName, cluster, workerPoolName)
+	rsyslogValues["tlsLib"] = getTLSLib(rsyslogRelpConfig)
+	rsyslogValues["additionalTargets"] = additionalTargets
+	rsyslogValues["excludeFilters"] = computeLogFilters(rsyslogRelpConfig.ExcludeRules, properties["msgVariable"].(string))
+	rsyslogValues["httpOutput"] = protocols.HasAny(rsyslog.ProtocolHTTP, rsyslog.ProtocolOTLP) || valiPush != nil
+	rsyslogValues["otlpOutput"] = protocols.Has(rsyslog.ProtocolOTLP)
+	rsyslogValues["httpRetryOutput"] = usesHTTPRetry(rsyslogRelpConfig)
+
+	if protocols.Has(rsyslog.ProtocolOTLP) {
+		otlpResourceAttributes, err := computeOTLPResourceAttributes(rsyslogValues["metadata"].([]map[string]string))
+		if err != nil {
+			return nil, err
+		}
+		rsyslogValues["otlpResourceAttributes"] = otlpResourceAttributes
+	}
+
+	if containerLogs := rsyslogRelpConfig.ContainerLogs; containerLogs != nil && containerLogs.Enabled {
+		containerLogsValues, err := getContainerLogsValues(containerLogs, ptr.Deref(rsyslogRelpConfig.OutputFormat, ""), cluster)
+		if err != nil {
+			return nil, err
+		}
+		rsyslogValues["containerLogs"] = containerLogsValues
+	}
+
+	if kernelLogs := rsyslogRelpConfig.KernelLogs; kernelLogs != nil && kernelLogs.Enabled {
+		rsyslogValues["kernelLogs"] = getKernelLogsValues(kernelLogs, input, properties["msgVariable"].(string))
+		ruleLimits = append(ruleLimits, computeRuleLimits(kernelLogs.LoggingRules, kernelLoggingRulePrefix)...)
+	}
+	rsyslogValues["ruleLimits"] = ruleLimits
+	rsyslogValues["customFields"] = getCustomFields(rsyslogRelpConfig)
+
+	// The log messages are pushed to the Vali instance of the shoot with the credentials of the logging agent on the
+	// nodes, hence they are only pushed if the logging agent is configured.
+	if vali := rsyslogRelpConfig.Vali; vali != nil && vali.Enabled && valiPush != nil {
+		rsyslogValues["vali"] = getValiValues(valiPush)
+	}
+
+	if localCopy := rsyslogRelpConfig.LocalCopy; localCopy != nil && localCopy.Enabled {
+		rsyslogValues["localCopy"] = map[string]interface{}{
+			"file":             path.Join(constants.RsyslogLocalCopyDir, localCopyFileName),
+			"maxSize":          ptr.Deref(localCopy.MaxSize, defaultLocalCopyMaxSize),
+			"rotateScriptPath": constants.RotateLocalCopyScriptPath,
+		}
+	}
+
+	if len(rsyslogRelpConfig.Redaction) > 0 {
+		rsyslogValues["redaction"] = map[string]interface{}{
+			"match":      computeRedactionFilter(rsyslogRelpConfig.Redaction, properties["msgVariable"].(string)),
+			"scriptPath": constants.RedactMessagesScriptPath,
+		}
+	}
+
+	if auditStream := rsyslogRelpConfig.AuditStream; auditStream != nil && auditStream.Enabled {
+		auditStreamValues := getAuditStreamValues(rsyslogRelpConfig, properties)
+		// The audit events are still pushed to Vali and written to the local copy like the other log messages.
+		for _, key := range []string{"vali", "localCopy"} {
+			if value, ok := rsyslogValues[key]; ok {
+				auditStreamValues[key] = value
+			}
+		}
+		rsyslogValues["auditStream"] = auditStreamValues
+	}
+
+	return rsyslogValues, nil
+}
+
+// getProtocols returns the protocols used for sending log messages to the primary, failover and additional target
+// servers.
+func getProtocols(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig) sets.Set[rsyslog.Protocol] {
+	protocols := sets.New(ptr.Deref(rsyslogRelpConfig.Protocol, rsyslog.ProtocolRELP))
+
+	if failoverTarget := rsyslogRelpConfig.FailoverTarget; failoverTarget != nil {
+		protocols.Insert(ptr.Deref(failoverTarget.Protocol, rsyslog.ProtocolRELP))
+	}
+
+	for _, additionalTarget := range rsyslogRelpConfig.AdditionalTargets {
+		protocols.Insert(ptr.Deref(additionalTarget.Protocol, rsyslog.ProtocolRELP))
+	}
+
+	return protocols
+}
+
+// usesHTTPRetry returns whether log messages rejected by any of the http target servers are sent again.
+func usesHTTPRetry(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig) bool {
+	httpConfigs := []*rsyslog.HTTP{rsyslogRelpConfig.HTTP}
+
+	if failoverTarget := rsyslogRelpConfig.FailoverTarget; failoverTarget != nil {
+		httpConfigs = append(httpConfigs, failoverTarget.HTTP)
+	}
+
+	for _, additionalTarget := range rsyslogRelpConfig.AdditionalTargets {
+		httpConfigs = append(httpConfigs, additionalTarget.HTTP)
+	}
+
+	return slices.ContainsFunc(httpConfigs, func(http *rsyslog.HTTP) bool {
+		return http != nil && ptr.Deref(http.Retry, false)
+	})
+}
+
+// getKernelLogsValues returns the values for forwarding the messages of the kernel ring buffer. When rsyslog reads
+// from the journal, kernel messages are already part of its input. Otherwise, they are read via imklog, as journald
+// does not forward kernel messages to the syslog socket.
+func getKernelLogsValues(kernelLogs *rsyslog.KernelLogs, input *rsyslog.Input, msgVariable string) map[string]interface{} {
+	values := map[string]interface{}{
+		"rules": computeLogRules(kernelLogs.LoggingRules, kernelLoggingRulePrefix, msgVariable),
+	}
+
+	if ptr.Deref(input.Mode, rsyslog.InputModeImuxsock) == rsyslog.InputModeImjournal {
+		values["match"] = `$!_TRANSPORT == "kernel"`
+	} else {
+		values["match"] = `$inputname == "imklog"`
+		values["imklog"] = true
+	}
+
+	return values
+}
+
+// getContainerLogsValues returns the values for reading the container logs from the log files written by the kubelet
+// and enriching them with the metadata of their pods. The metadata is retrieved by rsyslog from the kube-apiserver
+// with the token of the pod metadata reader.
+func getContainerLogsValues(containerLogs *rsyslog.ContainerLogs, outputFormat rsyslog.OutputFormat, cluster *extensionscontroller.Cluster) (map[string]interface{}, error) {
+	kubernetesURL := getAPIServerURL(cluster)
+	if kubernetesURL == "" {
+		return nil, fmt.Errorf("failed to determine the address of the kube-apiserver of shoot %s", cluster.Shoot.Name)
+	}
+
+	var namespaces []string
+	for _, namespace := range containerLogs.Namespaces {
+		namespaces = append(namespaces, strconv.Quote(namespace))
+	}
+
+	var metadata string
+	switch outputFormat {
+	case rsyslog.OutputFormatRFC5424:
+		metadata = `" namespace=\"" & $!kubernetes!namespace_name & "\" pod=\"" & $!kubernetes!pod_name & "\" container=\"" & $!kubernetes!container_name & "\" labels=\"" & replace(replace($!kubernetes!labels, "\\", "\\\\"), "\"", "\\\"") & "\""`
+	case "":
+		metadata = `" " & $!kubernetes!namespace_name & " " & $!kubernetes!pod_name & " " & $!kubernetes!container_name`
+	}
+
+	return map[string]interface{}{
+		"podLogsDir":     podLogsDir,
+		"namespaces":     strings.Join(namespaces, ","),
+		"podSelector":    computePodSelectorFilter(containerLogs.PodSelector),
+		"metadata":       metadata,
+		"kubernetesURL":  kubernetesURL,
+		"caPath":         kubeletCACertPath,
+		"tokenPath":      path.Join(constants.RsyslogTLSDir, podMetadataReaderTokenDir, httpTokenFileName),
+		"joinScriptPath": constants.JoinContainerLogLinesScriptPath,
+	}, nil
+}
+
+// getAPIServerURL returns the URL under which the nodes reach the kube-apiserver of the shoot. The internal
+// address is preferred as it is also used by the kubelet.
+func getAPIServerURL(cluster *extensionscontroller.Cluster) string {
+	var url string
+	for _, address := range cluster.Shoot.Status.AdvertisedAddresses {
+		switch address.Name {
+		case "internal":
+			return address.URL
+		case "external":
+			url = address.URL
+		}
+	}
+	return url
+}
+
+// computePodSelectorFilter returns an rsyslog expression which matches the labels of the pods added by
+// mmkubernetes against the given label selector.
+func computePodSelectorFilter(podSelector *metav1.LabelSelector) string {
+	if podSelector == nil {
+		return ""
+	}
+
+	podLabel := func(key string) string {
+		return fmt.Sprintf("get_property($!kubernetes!labels, %s)", strconv.Quote(key))
+	}
+
+	var filters []string
+	for _, key := range slices.Sorted(maps.Keys(podSelector.MatchLabels)) {
+		filters = append(filters, fmt.Sprintf("%s == %s", podLabel(key), strconv.Quote(podSelector.MatchLabels[key])))
+	}
+	for _, requirement := range podSelector.MatchExpressions {
+		var values []string
+		for _, value := range requirement.Values {
+			values = append(values, strconv.Quote(value))
+		}
+
+		switch requirement.Operator {
+		case metav1.LabelSelectorOpIn:
+			filters = append(filters, fmt.Sprintf("%s == [%s]", podLabel(requirement.Key), strings.Join(values, ",")))
+		case metav1.LabelSelectorOpNotIn:
+			filters = append(filters, fmt.Sprintf("not (%s == [%s])", podLabel(requirement.Key), strings.Join(values, ",")))
+		case metav1.LabelSelectorOpExists:
+			filters = append(filters, fmt.Sprintf(`%s != ""`, podLabel(requirement.Key)))
+		case metav1.LabelSelectorOpDoesNotExist:
+			filters = append(filters, fmt.Sprintf(`%s == ""`, podLabel(requirement.Key)))
+		}
+	}
+
+	return strings.Join(filters, " and ")
+}
+
+// getMetadata returns the key/value pairs which are added to every log message. The static metadata is sorted by
+// key, so that its position in log messages sent in the default output format is stable.
+func getMetadata(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig, projectName string, cluster *extensionscontroller.Cluster, workerPoolName string) []map[string]string {
+	metadata := []map[string]string{
+		{"key": "projectName", "value": projectName},
+		{"key": "shootName", "value": cluster.Shoot.Name},
+		{"key": "shootUID", "value": string(cluster.Shoot.UID)},
+	}
+
+	if rsyslogRelpConfig.NodeMetadata != nil && rsyslogRelpConfig.NodeMetadata.Enabled {
+		metadata = append(metadata, getNodeMetadata(cluster, workerPoolName)...)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(rsyslogRelpConfig.StaticMetadata)) {
+		metadata = append(metadata, map[string]string{"key": key, "value": rsyslogRelpConfig.StaticMetadata[key]})
+	}
+
+	return metadata
+}
+
+// otlpAttribute is a key/value pair with a string value of the OpenTelemetry log data model.
+type otlpAttribute struct {
+	Key   string `json:"key"`
+	Value struct {
+		StringValue string `json:"stringValue"`
+	} `json:"value"`
+}
+
+// rsyslogStringEscaper escapes a value for a string constant of an rsyslog template.
+var rsyslogStringEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// computeOTLPResourceAttributes returns the metadata as attributes of the OTLP resource. The attributes are encoded as
+// JSON and escaped for the string constants of the OTLP template, so that the metadata cannot break the sent JSON.
+func computeOTLPResourceAttributes(metadata []map[string]string) ([]string, error) {
+	var attributes []string
+	for _, entry := range metadata {
+		attribute := otlpAttribute{Key: entry["key"]}
+		attribute.Value.StringValue = entry["value"]
+
+		encoded, err := json.Marshal(attribute)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, rsyslogStringEscaper.Replace(string(encoded)))
+	}
+	return attributes, nil
+}
+
+// getNodeMetadata returns the metadata of the nodes of the given worker pool. All keys are always returned, so that
+// the positions of the values in log messages sent in the default output format do not depend on the worker pool.
+func getNodeMetadata(cluster *extensionscontroller.Cluster, workerPoolName string) []map[string]string {
+	var machineType, zones string
+	kubernetesVersion := cluster.Shoot.Spec.Kubernetes.Version
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		if worker.Name != workerPoolName {
+			continue
+		}
+		machineType = worker.Machine.Type
+		zones = strings.Join(worker.Zones, ",")
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			kubernetesVersion = *worker.Kubernetes.Version
+		}
+	}
+
+	seedName := ptr.Deref(cluster.Shoot.Spec.SeedName, "")
+	if cluster.Seed != nil {
+		seedName = cluster.Seed.Name
+	}
+
+	return []map[string]string{
+		{"key": "workerPool", "value": workerPoolName},
+		{"key": "machineType", "value": machineType},
+		{"key": "zones", "value": zones},
+		{"key": "region", "value": cluster.Shoot.Spec.Region},
+		{"key": "seedName", "value": seedName},
+		{"key": "kubernetesVersion", "value": kubernetesVersion},
+	}
+}
+
+func getRelpTargetValues(queue *rsyslog.Queue, properties map[string]interface{}, relpTarget *rsyslog.RelpTarget) map[string]interface{} {
 	var reportSuspensionContinuation *string
-	if rsyslogRelpConfig.ReportSuspensionContinuation != nil {
-		if *rsyslogRelpConfig.ReportSuspensionContinuation {
+	if relpTarget.ReportSuspensionContinuation != nil {
+		if *relpTarget.ReportSuspensionContinuation {
 			reportSuspensionContinuation = ptr.To("on")
 		} else {
 			reportSuspensionContinuation = ptr.To("off")
 		}
 	}
 
-	filters := computeLogFilters(rsyslogRelpConfig.LoggingRules)
+	rulesetName, actionName, queueFileName := "relp_action_ruleset", "rsyslog-relp", "rsyslog-relp-queue"
+	if relpTarget.Name != "" {
+		rulesetName += "_" + relpTarget.Name
+		actionName += "-" + relpTarget.Name
+		queueFileName += "-" + relpTarget.Name
+	}
 
-	return map[string]interface{}{
-		"target":                       rsyslogRelpConfig.Target,
-		"port":                         rsyslogRelpConfig.Port,
-		"rsyslogRelpQueueSpoolDir":     constants.RsyslogRelpQueueSpoolDir,
-		"projectName":                  projectName,
-		"shootName":                    cluster.Shoot.Name,
-		"shootUID":                     cluster.Shoot.UID,
-		"filters":                      filters,
-		"rebindInterval":               rsyslogRelpConfig.RebindInterval,
-		"timeout":                      rsyslogRelpConfig.Timeout,
-		"resumeRetryCount":             rsyslogRelpConfig.ResumeRetryCount,
+	protocol := ptr.Deref(relpTarget.Protocol, rsyslog.ProtocolRELP)
+
+	// Log messages sent via OTLP/HTTP are converted into OpenTelemetry log records instead of being formatted
+	// according to the configured output format.
+	templateName := "SyslogForwarderTemplate"
+	if protocol == rsyslog.ProtocolOTLP {
+		templateName = "OTLPForwarderTemplate"
+	}
+
+	values := map[string]interface{}{
+		"rulesetName":                  rulesetName,
+		"actionName":                   actionName,
+		"actionQueueParameters":        getQueueParameters(queue, queueFileName),
+		"protocol":                     string(protocol),
+		"omhttp":                       protocol == rsyslog.ProtocolHTTP || protocol == rsyslog.ProtocolOTLP,
+		"template":                     templateName,
+		"target":                       relpTarget.Target,
+		"port":                         relpTarget.Port,
+		"rules":                        computeLogRules(relpTarget.LoggingRules, loggingRulePrefix(relpTarget.Name), properties["msgVariable"].(string)),
+		"rebindInterval":               relpTarget.RebindInterval,
+		"timeout":                      relpTarget.Timeout,
+		"resumeRetryCount":             relpTarget.ResumeRetryCount,
 		"reportSuspensionContinuation": reportSuspensionContinuation,
+		"properties":                   properties,
+	}
+
+	if relpTarget.TLS != nil && relpTarget.TLS.Enabled {
+		values["tls"] = getRsyslogTLSValues(relpTarget.TLS, relpTarget.Name)
+	}
+
+	// The omhttp module expects the timeout of its requests in milliseconds.
+	if values["omhttp"] == true && relpTarget.Timeout != nil {
+		values["restPathTimeout"] = *relpTarget.Timeout * 1000
+		delete(values, "timeout")
+	}
+
+	if protocol == rsyslog.ProtocolOTLP {
+		httpConfig := ptr.Deref(relpTarget.HTTP, rsyslog.HTTP{})
+		if httpConfig.Path == nil {
+			httpConfig.Path = ptr.To(defaultOTLPLogsPath)
+		}
+		values["http"] = getHTTPValues(&httpConfig, relpTarget.Name)
+	} else if relpTarget.HTTP != nil {
+		values["http"] = getHTTPValues(relpTarget.HTTP, relpTarget.Name)
+	}
+
+	if relpTarget.HTTP != nil && ptr.Deref(relpTarget.HTTP.Retry, false) {
+		values["httpRetry"] = getHTTPRetryValues(values, queue, queueFileName)
+	}
+
+	return values
+}
+
+// getAuditStreamValues returns the values of the rsyslog relp action which sends the audit events to the primary
+// target server. The action retries endlessly while the target server is not reachable. Its queue blocks new audit
+// events instead of discarding them once it is full, hence its disk space is limited like the one of the other queues,
+// so that the spooled audit events cannot fill up the disk of the node.
+func getAuditStreamValues(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig, properties map[string]interface{}) map[string]interface{} {
+	auditStream := rsyslogRelpConfig.AuditStream
+	queue := ptr.Deref(auditStream.Queue, rsyslog.AuditStreamQueue{})
+
+	// The audit stream uses the tls files and the http token of the primary target server, hence only the names of
+	// its ruleset, action and queue are suffixed.
+	values := getRelpTargetValues(nil, properties, &rsyslog.RelpTarget{
+		Target:                       rsyslogRelpConfig.Target,
+		Port:                         ptr.Deref(auditStream.Port, rsyslogRelpConfig.Port),
+		Protocol:                     rsyslogRelpConfig.Protocol,
+		HTTP:                         rsyslogRelpConfig.HTTP,
+		TLS:                          rsyslogRelpConfig.TLS,
+		RebindInterval:               rsyslogRelpConfig.RebindInterval,
+		Timeout:                      rsyslogRelpConfig.Timeout,
+		ResumeRetryCount:             ptr.To(-1),
+		ReportSuspensionContinuation: rsyslogRelpConfig.ReportSuspensionContinuation,
+	})
+	values["rulesetName"] = "relp_action_ruleset_" + auditStreamName
+	values["actionName"] = "rsyslog-relp-" + auditStreamName
+	values["actionQueueParameters"] = append(getQueueParameters(&rsyslog.Queue{
+		Size:         queue.Size,
+		MaxDiskSpace: queue.MaxDiskSpace,
+	}, "rsyslog-relp-queue-"+auditStreamName), fmt.Sprintf("queue.timeoutEnqueue=\"%d\"", auditStreamQueueTimeoutEnqueue))
+
+	if values["httpRetry"] != nil {
+		values["httpRetry"] = getHTTPRetryValues(values, &rsyslog.Queue{
+			Size:         queue.Size,
+			MaxDiskSpace: queue.MaxDiskSpace,
+		}, "rsyslog-relp-queue-"+auditStreamName)
+	}
+
+	return values
+}
+
+// getHTTPRetryValues returns the values of the ruleset to which the omhttp module submits the log messages rejected by
+// the target server. The log messages submitted to it are already rendered, hence its action sends them unchanged to
+// the same endpoint and submits them to the ruleset again if they are rejected once more. The action has a queue of its
+// own, so that retrying rejected log messages does not block the main queue. The names of the target servers cannot
+// contain underscores, hence the names of the retry ruleset, action and queue do not clash with the ones of others.
+func getHTTPRetryValues(values map[string]interface{}, queue *rsyslog.Queue, queueFileName string) map[string]interface{} {
+	retryValues := maps.Clone(values)
+	retryValues["rulesetName"] = strings.Replace(values["rulesetName"].(string), "relp_action_ruleset", "relp_action_retry_ruleset", 1)
+	retryValues["actionName"] = values["actionName"].(string) + "_retry"
+	retryValues["actionQueueParameters"] = getQueueParameters(queue, queueFileName+"_retry")
+	retryValues["template"] = "HTTPRetryTemplate"
+	retryValues["retryAction"] = true
+	delete(retryValues, "httpRetry")
+
+	// The values of the http endpoint are shared by both actions, so that both submit rejected log messages to the
+	// retry ruleset.
+	values["http"].(map[string]interface{})["retryRuleset"] = retryValues["rulesetName"]
+
+	return retryValues
+}
+
+func getHTTPValues(http *rsyslog.HTTP, subDir string) map[string]interface{} {
+	values := map[string]interface{}{
+		"path": ptr.Deref(http.Path, ""),
+	}
+
+	// The token is not part of the rsyslog configuration, instead rsyslog reads it from the file the referenced
+	// secret is stored in next to the tls files.
+	if http.TokenSecretReferenceName != nil {
+		values["tokenPath"] = path.Join(constants.RsyslogTLSDir, subDir, httpTokenFileName)
+	}
+
+	if batch := http.Batch; batch != nil {
+		values["batch"] = map[string]interface{}{
+			"format":   string(ptr.Deref(batch.Format, rsyslog.HTTPBatchFormatNewline)),
+			"maxSize":  batch.MaxSize,
+			"maxBytes": batch.MaxBytes,
+		}
+	}
+
+	return values
+}
+
+// getQueueParameters returns the parameters of a disk-assisted queue.
+func getQueueParameters(queue *rsyslog.Queue, queueFileName string) []string {
+	if queue == nil {
+		queue = &rsyslog.Queue{}
+	}
+
+	size := ptr.Deref(queue.Size, defaultQueueSize)
+	maxDiskSpace := ptr.Deref(queue.MaxDiskSpace, defaultQueueMaxDiskSpace)
+
+	queueParameters := []string{
+		`queue.type="linkedlist"`,
+		fmt.Sprintf("queue.size=\"%d\"", size),
+		fmt.Sprintf("queue.filename=%q", queueFileName),
+		`queue.saveOnShutdown="on"`,
+		fmt.Sprintf("queue.spoolDirectory=%q", constants.RsyslogRelpQueueSpoolDir),
+		fmt.Sprintf("queue.maxDiskSpace=%q", maxDiskSpace),
+	}
+
+	for _, option := range []struct {
+		name  string
+		value *int
+	}{
+		{"queue.highWatermark", queue.HighWatermark},
+		{"queue.lowWatermark", queue.LowWatermark},
+		{"queue.discardMark", queue.DiscardMark},
+		{"queue.discardSeverity", queue.DiscardSeverity},
+		{"queue.dequeueBatchSize", queue.DequeueBatchSize},
+		{"queue.workerThreads", queue.WorkerThreads},
+	} {
+		if option.value != nil {
+			queueParameters = append(queueParameters, fmt.Sprintf("%s=\"%d\"", option.name, *option.value))
+		}
+	}
+
+	return queueParameters
+}
+
+// getTLSLib returns the tls library for the omrelp module. As the library can only be configured once per module,
+// validation ensures that all targets which specify a tls library use the same one.
+func getTLSLib(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig) string {
+	if rsyslogRelpConfig.TLS != nil && rsyslogRelpConfig.TLS.TLSLib != nil {
+		return string(*rsyslogRelpConfig.TLS.TLSLib)
+	}
+
+	if failoverTarget := rsyslogRelpConfig.FailoverTarget; failoverTarget != nil && failoverTarget.TLS != nil && failoverTarget.TLS.TLSLib != nil {
+		return string(*failoverTarget.TLS.TLSLib)
+	}
+
+	for _, additionalTarget := range rsyslogRelpConfig.AdditionalTargets {
+		if additionalTarget.TLS != nil && additionalTarget.TLS.TLSLib != nil {
+			return string(*additionalTarget.TLS.TLSLib)
+		}
 	}
+
+	return ""
 }
 
-func getRsyslogTLSValues(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig) map[string]interface{} {
+func getRsyslogTLSValues(tls *rsyslog.TLS, subDir string) map[string]interface{} {
 	var permittedPeers []string
-	for _, permittedPeer := range rsyslogRelpConfig.TLS.PermittedPeer {
+	for _, permittedPeer := range tls.PermittedPeer {
 		permittedPeers = append(permittedPeers, strconv.Quote(permittedPeer))
 	}
 
 	var authMode string
-	if rsyslogRelpConfig.TLS.AuthMode != nil {
-		authMode = string(*rsyslogRelpConfig.TLS.AuthMode)
+	if tls.AuthMode != nil {
+		authMode = string(*tls.AuthMode)
 	}
 
-	var tlsLib string
-	if rsyslogRelpConfig.TLS.TLSLib != nil {
-		tlsLib = string(*rsyslogRelpConfig.TLS.TLSLib)
+	tlsDir := path.Join(constants.RsyslogTLSDir, subDir)
+
+	// The omfwd module configures TLS via network stream drivers, which use different names for the tls libraries
+	// and authentication modes than the omrelp module.
+	streamDriver := "gtls"
+	if ptr.Deref(tls.TLSLib, "") == rsyslog.TLSLibOpenSSL {
+		streamDriver = "ossl"
+	}
+	var streamDriverAuthMode string
+	if authMode != "" {
+		streamDriverAuthMode = "x509/" + authMode
 	}
 
 	return map[string]interface{}{
-		"caPath":        constants.RsyslogTLSDir + "/ca.crt",
-		"certPath":      constants.RsyslogTLSDir + "/tls.crt",
-		"keyPath":       constants.RsyslogTLSDir + "/tls.key",
-		"enabled":       rsyslogRelpConfig.TLS.Enabled,
-		"permittedPeer": strings.Join(permittedPeers, ","),
-		"authMode":      authMode,
-		"tlsLib":        tlsLib,
+		"caPath":                     tlsDir + "/ca.crt",
+		"certPath":                   tlsDir + "/tls.crt",
+		"keyPath":                    tlsDir + "/tls.key",
+		"enabled":                    tls.Enabled,
+		"permittedPeer":              strings.Join(permittedPeers, ","),
+		"authMode":                   authMode,
+		"streamDriver":               streamDriver,
+		"streamDriverAuthMode":       streamDriverAuthMode,
+		"streamDriverPermittedPeers": strings.Join(tls.PermittedPeer, ","),
 	}
 }
 
-func getRsyslogTLSFiles(cluster *extensionscontroller.Cluster, secretRefName string) ([]extensionsv1alpha1.File, error) {
+func getRsyslogTLSFiles(cluster *extensionscontroller.Cluster, secretRefName, subDir string) ([]extensionsv1alpha1.File, error) {
 	ref := v1beta1helper.GetResourceByName(cluster.Shoot.Spec.Resources, secretRefName)
 	if ref == nil || ref.ResourceRef.Kind != "Secret" {
 		return nil, fmt.Errorf("failed to find referenced resource with name %s and kind Secret", secretRefName)
 	}
 
 	refSecretName := v1beta1constants.ReferencedResourcesPrefix + ref.ResourceRef.Name
+	tlsFromOSCDir := path.Join(constants.RsyslogTLSFromOSCDir, subDir)
 	return []extensionsv1alpha1.File{
 		{
-			Path:        constants.RsyslogTLSFromOSCDir + "/ca.crt",
+			Path:        tlsFromOSCDir + "/ca.crt",
 			Permissions: ptr.To(uint32(0600)),
 			Content: extensionsv1alpha1.FileContent{
 				SecretRef: &extensionsv1alpha1.FileContentSecretRef{
@@ -236,7 +941,7 @@ func getRsyslogTLSFiles(cluster *extensionscontroller.Cluster, secretRefName str
 			},
 		},
 		{
-			Path:        constants.RsyslogTLSFromOSCDir + "/tls.crt",
+			Path:        tlsFromOSCDir + "/tls.crt",
 			Permissions: ptr.To(uint32(0600)),
 			Content: extensionsv1alpha1.FileContent{
 				SecretRef: &extensionsv1alpha1.FileContentSecretRef{
@@ -246,7 +951,7 @@ func getRsyslogTLSFiles(cluster *extensionscontroller.Cluster, secretRefName str
 			},
 		},
 		{
-			Path:        constants.RsyslogTLSFromOSCDir + "/tls.key",
+			Path:        tlsFromOSCDir + "/tls.key",
 			Permissions: ptr.To(uint32(0600)),
 			Content: extensionsv1alpha1.FileContent{
 				SecretRef: &extensionsv1alpha1.FileContentSecretRef{
@@ -258,6 +963,39 @@ func getRsyslogTLSFiles(cluster *extensionscontroller.Cluster, secretRefName str
 	}, nil
 }
 
+func getHTTPTokenFile(cluster *extensionscontroller.Cluster, secretRefName, subDir string) (extensionsv1alpha1.File, error) {
+	ref := v1beta1helper.GetResourceByName(cluster.Shoot.Spec.Resources, secretRefName)
+	if ref == nil || ref.ResourceRef.Kind != "Secret" {
+		return extensionsv1alpha1.File{}, fmt.Errorf("failed to find referenced resource with name %s and kind Secret", secretRefName)
+	}
+
+	return extensionsv1alpha1.File{
+		Path:        path.Join(constants.RsyslogTLSFromOSCDir, subDir, httpTokenFileName),
+		Permissions: ptr.To(uint32(0600)),
+		Content: extensionsv1alpha1.FileContent{
+			SecretRef: &extensionsv1alpha1.FileContentSecretRef{
+				Name:    v1beta1constants.ReferencedResourcesPrefix + ref.ResourceRef.Name,
+				DataKey: constants.RsyslogHTTPTokenKey,
+			},
+		},
+	}, nil
+}
+
+// getPodMetadataReaderTokenFile returns the file containing the token of the ServiceAccount with which rsyslog reads
+// the metadata of the pods. It is stored next to the tls files, so that rsyslog is restarted when the token is renewed.
+func getPodMetadataReaderTokenFile() extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        path.Join(constants.RsyslogTLSFromOSCDir, podMetadataReaderTokenDir, httpTokenFileName),
+		Permissions: ptr.To(uint32(0600)),
+		Content: extensionsv1alpha1.FileContent{
+			SecretRef: &extensionsv1alpha1.FileContentSecretRef{
+				Name:    v1beta1constants.SecretNamePrefixShootAccess + constants.PodMetadataReaderName,
+				DataKey: resourcesv1alpha1.DataKeyToken,
+			},
+		},
+	}
+}
+
 func getRsyslogConfiguratorUnit() extensionsv1alpha1.Unit {
 	return extensionsv1alpha1.Unit{
 		Name:    "rsyslog-configurator.service",
@@ -276,31 +1014,405 @@ WantedBy=multi-user.target`),
 	}
 }
 
-func computeLogFilters(loggingRules []rsyslog.LoggingRule) []string {
+// computeLogFilters returns the filters of the logging rules. The message text is matched against the given variable.
+func computeLogFilters(loggingRules []rsyslog.LoggingRule, msgVariable string) []string {
 	var filters []string
 	for _, rule := range loggingRules {
-		var programNames []string
 		var currentFilters []string
-		for _, programName := range rule.ProgramNames {
-			programNames = append(programNames, strconv.Quote(programName))
+		if len(rule.ProgramNames) > 0 {
+			currentFilters = append(currentFilters, computeProgramNamesFilter(rule.ProgramNames))
+		}
+		var systemdUnits []string
+		for _, systemdUnit := range rule.SystemdUnits {
+			systemdUnits = append(systemdUnits, strconv.Quote(systemdUnit))
+		}
+		if len(systemdUnits) > 0 {
+			currentFilters = append(currentFilters, fmt.Sprintf("$!_SYSTEMD_UNIT == [%s]", strings.Join(systemdUnits, ",")))
 		}
-		if len(programNames) > 0 {
-			currentFilters = append(currentFilters, fmt.Sprintf("$programname == [%s]", strings.Join(programNames, ",")))
+		var facilities []string
+		for _, facility := range rule.Facilities {
+			facilities = append(facilities, strconv.Quote(facility))
+		}
+		if len(facilities) > 0 {
+			currentFilters = append(currentFilters, fmt.Sprintf("$syslogfacility-text == [%s]", strings.Join(facilities, ",")))
 		}
 		if rule.Severity != nil {
 			currentFilters = append(currentFilters, fmt.Sprintf("$syslogseverity <= %d", *rule.Severity))
 		}
+		if severityRange := rule.SeverityRange; severityRange != nil {
+			currentFilters = append(currentFilters, computeSeverityRangeFilters(severityRange)...)
+		}
 		if rule.MessageContent != nil {
 			if include := rule.MessageContent.Regex; include != nil {
 				quotedRegex := strconv.Quote(*include)
-				currentFilters = append(currentFilters, fmt.Sprintf("re_match($msg, %s) == 1", quotedRegex))
+				currentFilters = append(currentFilters, fmt.Sprintf("re_match(%s, %s) == 1", msgVariable, quotedRegex))
 			}
 			if exclude := rule.MessageContent.Exclude; exclude != nil {
 				quotedRegex := strconv.Quote(*exclude)
-				currentFilters = append(currentFilters, fmt.Sprintf("re_match($msg, %s) == 0", quotedRegex))
+				currentFilters = append(currentFilters, fmt.Sprintf("re_match(%s, %s) == 0", msgVariable, quotedRegex))
 			}
 		}
+		for _, matcher := range rule.PropertyMatchers {
+			currentFilters = append(currentFilters, computePropertyMatcherFilter(matcher, msgVariable))
+		}
+		if rule.Condition != nil {
+			currentFilters = append(currentFilters, computeConditionFilter(*rule.Condition, msgVariable))
+		}
 		filters = append(filters, strings.Join(currentFilters, " and "))
 	}
 	return filters
 }
+
+// computeLogRules returns the filters of the logging rules. Rules which are rate limited or sampled also contain the
+// name of the ruleset enforcing their limits, rules with transformations the statements applying them.
+func computeLogRules(loggingRules []rsyslog.LoggingRule, prefix, msgVariable string) []map[string]interface{} {
+	var rules []map[string]interface{}
+	for index, filter := range computeLogFilters(loggingRules, msgVariable) {
+		rule := map[string]interface{}{
+			"filter": filter,
+		}
+		if loggingRules[index].RateLimit != nil || loggingRules[index].Sampling != nil {
+			rule["limits"] = fmt.Sprintf("%s%d_limits", prefix, index)
+		}
+		if transformations := loggingRules[index].Transformations; len(transformations) > 0 {
+			rule["transformations"] = computeTransformations(transformations)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// computeTransformations returns the statements setting the variables which are sent to the target servers instead of
+// the corresponding properties of the log messages.
+func computeTransformations(transformations []rsyslog.Transformation) []string {
+	var statements []string
+	for _, transformation := range transformations {
+		if severity := transformation.Severity; severity != nil {
+			statements = append(statements,
+				fmt.Sprintf("set $.severity = %d;", *severity),
+				fmt.Sprintf("set $.severity_text = %s;", strconv.Quote(rsyslog.SeverityNames[*severity])),
+				fmt.Sprintf("set $.pri = $syslogfacility * 8 + %d;", *severity),
+			)
+		}
+		if syslogTag := transformation.SyslogTag; syslogTag != nil {
+			statements = append(statements, fmt.Sprintf("set $.syslogtag = %s;", strconv.Quote(*syslogTag)))
+		}
+		if appName := transformation.AppName; appName != nil {
+			statements = append(statements, fmt.Sprintf("set $.app_name = %s;", strconv.Quote(*appName)))
+		}
+		if customField := transformation.Field; customField != nil {
+			statements = append(statements, fmt.Sprintf("set $.fields!%s = %s;", customField.Name, strconv.Quote(customField.Value)))
+		}
+	}
+	return statements
+}
+
+// allLoggingRules returns the logging rules of the primary, additional and kernel targets.
+func allLoggingRules(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig) []rsyslog.LoggingRule {
+	loggingRules := slices.Clone(rsyslogRelpConfig.LoggingRules)
+	for _, additionalTarget := range rsyslogRelpConfig.AdditionalTargets {
+		loggingRules = append(loggingRules, additionalTarget.LoggingRules...)
+	}
+	if kernelLogs := rsyslogRelpConfig.KernelLogs; kernelLogs != nil && kernelLogs.Enabled {
+		loggingRules = append(loggingRules, kernelLogs.LoggingRules...)
+	}
+	return loggingRules
+}
+
+// getMessageProperties returns the names of the properties of the log messages which are sent to the target servers.
+// If any logging rule has transformations, the properties are copied to variables which can be changed by the
+// transformations. If container logs are forwarded, the message is copied to a variable from which the prefix of the
+// container runtime is removed, the logging, exclude and redaction rules match the message text of this variable. The
+// severity and the message variable are used in expressions, the other properties in templates.
+func getMessageProperties(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig) map[string]interface{} {
+	properties := map[string]interface{}{
+		"pri":          "pri",
+		"severity":     "$syslogseverity",
+		"severityText": "syslogseverity-text",
+		"syslogtag":    "syslogtag",
+		"appName":      "app-name",
+		"msg":          "msg",
+		"msgVariable":  "$msg",
+	}
+
+	for _, rule := range allLoggingRules(rsyslogRelpConfig) {
+		if len(rule.Transformations) > 0 {
+			properties["transformed"] = true
+			properties["pri"] = "$.pri"
+			properties["severity"] = "$.severity"
+			properties["severityText"] = "$.severity_text"
+			properties["syslogtag"] = "$.syslogtag"
+			properties["appName"] = "$.app_name"
+			break
+		}
+	}
+
+	if containerLogs := rsyslogRelpConfig.ContainerLogs; containerLogs != nil && containerLogs.Enabled {
+		properties["msg"] = "$.msg"
+		properties["msgVariable"] = "$.msg"
+	}
+
+	return properties
+}
+
+// getCustomFields returns the sorted names of the custom fields which are added to the log messages by
+// transformations.
+func getCustomFields(rsyslogRelpConfig *rsyslog.RsyslogRelpConfig) []string {
+	customFields := sets.New[string]()
+	for _, rule := range allLoggingRules(rsyslogRelpConfig) {
+		for _, transformation := range rule.Transformations {
+			if transformation.Field != nil {
+				customFields.Insert(transformation.Field.Name)
+			}
+		}
+	}
+	return sets.List(customFields)
+}
+
+// computeRuleLimits returns the rate limits and sampling of the logging rules. Their names are used for the rulesets
+// enforcing the limits, the global variables keeping track of the forwarded log messages and the dynamic statistics
+// counting the discarded log messages.
+func computeRuleLimits(loggingRules []rsyslog.LoggingRule, prefix string) []map[string]interface{} {
+	var ruleLimits []map[string]interface{}
+	for index, rule := range loggingRules {
+		if rule.RateLimit == nil && rule.Sampling == nil {
+			continue
+		}
+
+		limits := map[string]interface{}{
+			"name": fmt.Sprintf("%s%d", prefix, index),
+		}
+		if rateLimit := rule.RateLimit; rateLimit != nil {
+			limits["rateLimit"] = map[string]interface{}{
+				"interval": rateLimit.Interval,
+				"burst":    rateLimit.Burst,
+			}
+		}
+		if sampling := rule.Sampling; sampling != nil {
+			limits["sampling"] = map[string]interface{}{
+				"oneIn": sampling.OneIn,
+			}
+		}
+		ruleLimits = append(ruleLimits, limits)
+	}
+	return ruleLimits
+}
+
+// loggingRulePrefix returns the prefix of the names of the logging rules of the target server with the given name.
+// The rules of the primary target server have no name.
+func loggingRulePrefix(targetName string) string {
+	if targetName == "" {
+		return "logging_rule_"
+	}
+	return "logging_rule_" + strings.ReplaceAll(targetName, "-", "_") + "_"
+}
+
+// computeSeverityRangeFilters matches the severity exactly if the range contains only a single severity.
+func computeSeverityRangeFilters(severityRange *rsyslog.SeverityRange) []string {
+	if severityRange.Min != nil && severityRange.Max != nil && *severityRange.Min == *severityRange.Max {
+		return []string{fmt.Sprintf("$syslogseverity == %d", *severityRange.Min)}
+	}
+
+	var filters []string
+	if severityRange.Min != nil {
+		filters = append(filters, fmt.Sprintf("$syslogseverity >= %d", *severityRange.Min))
+	}
+	if severityRange.Max != nil {
+		filters = append(filters, fmt.Sprintf("$syslogseverity <= %d", *severityRange.Max))
+	}
+	return filters
+}
+
+// computeProgramNamesFilter matches the program names exactly unless they contain wildcards. Patterns with a single
+// trailing `*` are matched as prefixes, all others are converted to regular expressions.
+func computeProgramNamesFilter(programNames []string) string {
+	var exactNames, filters []string
+	for _, programName := range programNames {
+		prefix, isPrefix := strings.CutSuffix(programName, "*")
+		switch {
+		case !strings.ContainsAny(programName, programNameWildcards):
+			exactNames = append(exactNames, strconv.Quote(programName))
+		case isPrefix && !strings.ContainsAny(prefix, programNameWildcards):
+			filters = append(filters, fmt.Sprintf("$programname startswith %s", strconv.Quote(prefix)))
+		default:
+			filters = append(filters, fmt.Sprintf("re_match($programname, %s) == 1", strconv.Quote(globToRegex(programName))))
+		}
+	}
+	if len(exactNames) > 0 {
+		filters = append([]string{fmt.Sprintf("$programname == [%s]", strings.Join(exactNames, ","))}, filters...)
+	}
+
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return "(" + strings.Join(filters, " or ") + ")"
+}
+
+// globToRegex converts a pattern with the wildcards `*` and `?` to an anchored POSIX ERE regular expression.
+func globToRegex(pattern string) string {
+	var regex strings.Builder
+	regex.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			regex.WriteString(".*")
+		case '?':
+			regex.WriteString(".")
+		default:
+			regex.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	regex.WriteString("$")
+	return regex.String()
+}
+
+// messageProperties maps the properties which can be matched in logging rules to the names of the rsyslog properties.
+// The message text is matched against the variable returned by getMessageProperties instead.
+var messageProperties = map[rsyslog.MessageProperty]string{
+	rsyslog.MessagePropertyFacility:    "$syslogfacility-text",
+	rsyslog.MessagePropertyHostname:    "$hostname",
+	rsyslog.MessagePropertySyslogTag:   "$syslogtag",
+	rsyslog.MessagePropertyAppName:     "$app-name",
+	rsyslog.MessagePropertyProcID:      "$procid",
+	rsyslog.MessagePropertyProgramName: "$programname",
+}
+
+func computePropertyMatcherFilter(matcher rsyslog.PropertyMatcher, msgVariable string) string {
+	property := messageProperties[ptr.Deref(matcher.Property, "")]
+	if ptr.Deref(matcher.Property, "") == rsyslog.MessagePropertyMessage {
+		property = msgVariable
+	}
+	if matcher.JournalField != nil {
+		property = "$!" + *matcher.JournalField
+	}
+
+	quotedValue := strconv.Quote(matcher.Value)
+	switch ptr.Deref(matcher.Operator, rsyslog.PropertyMatchOperatorEquals) {
+	case rsyslog.PropertyMatchOperatorStartsWith:
+		return fmt.Sprintf("%s startswith %s", property, quotedValue)
+	case rsyslog.PropertyMatchOperatorRegex:
+		return fmt.Sprintf("re_match(%s, %s) == 1", property, quotedValue)
+	default:
+		return fmt.Sprintf("%s == %s", property, quotedValue)
+	}
+}
+
+// computeConditionFilter compiles the condition of a logging rule to a RainerScript expression. Nested conditions
+// are put in parentheses to preserve their precedence.
+func computeConditionFilter(condition rsyslog.Condition, msgVariable string) string {
+	switch {
+	case len(condition.AllOf) > 0:
+		return joinConditionFilters(condition.AllOf, " and ", msgVariable)
+	case len(condition.AnyOf) > 0:
+		return joinConditionFilters(condition.AnyOf, " or ", msgVariable)
+	case condition.Not != nil:
+		return fmt.Sprintf("not (%s)", computeConditionFilter(*condition.Not, msgVariable))
+	case condition.Match != nil:
+		return computePropertyMatcherFilter(*condition.Match, msgVariable)
+	default:
+		return fmt.Sprintf("$syslogseverity <= %d", ptr.Deref(condition.Severity, 7))
+	}
+}
+
+func joinConditionFilters(conditions []rsyslog.Condition, operator, msgVariable string) string {
+	var filters []string
+	for _, condition := range conditions {
+		filters = append(filters, computeConditionFilter(condition, msgVariable))
+	}
+	return "(" + strings.Join(filters, operator) + ")"
+}
+
+func getRotateLocalCopyScriptFile(localCopy *rsyslog.LocalCopy) (extensionsv1alpha1.File, error) {
+	var script bytes.Buffer
+	if err := rotateLocalCopyScriptTemplate.Execute(&script, map[string]interface{}{
+		"file":     path.Join(constants.RsyslogLocalCopyDir, localCopyFileName),
+		"maxFiles": ptr.Deref(localCopy.MaxFiles, defaultLocalCopyMaxFiles),
+		"maxAge":   ptr.Deref(localCopy.MaxAge, 0),
+	}); err != nil {
+		return extensionsv1alpha1.File{}, err
+	}
+
+	return extensionsv1alpha1.File{
+		Path:        constants.RotateLocalCopyScriptPath,
+		Permissions: ptr.To(uint32(0744)),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: "b64",
+				Data:     gardenerutils.EncodeBase64(script.Bytes()),
+			},
+		},
+	}, nil
+}
+
+// redactionPresetPatterns maps the built-in redaction presets to POSIX ERE regular expressions. The expressions are
+// evaluated by rsyslog to select the log messages which have to be redacted and by jq to replace the matches.
+var redactionPresetPatterns = map[rsyslog.RedactionPreset]string{
+	rsyslog.RedactionPresetJWT:          `eyJ[A-Za-z0-9_-]+\.eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`,
+	rsyslog.RedactionPresetAWSAccessKey: `(AKIA|ASIA)[A-Z0-9]{16}`,
+	rsyslog.RedactionPresetEmail:        `[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`,
+}
+
+func redactionPattern(rule rsyslog.RedactionRule) string {
+	if rule.Preset != nil {
+		return redactionPresetPatterns[*rule.Preset]
+	}
+	return ptr.Deref(rule.Pattern, "")
+}
+
+// computeRedactionFilter returns a filter matching the log messages which contain at least one match of the
+// redaction rules.
+func computeRedactionFilter(redaction []rsyslog.RedactionRule, msgVariable string) string {
+	var filters []string
+	for _, rule := range redaction {
+		filters = append(filters, fmt.Sprintf("re_match(%s, %s) == 1", msgVariable, strconv.Quote(redactionPattern(rule))))
+	}
+	return strings.Join(filters, " or ")
+}
+
+func getRedactMessagesScriptFile(redaction []rsyslog.RedactionRule) (extensionsv1alpha1.File, error) {
+	var rules []map[string]string
+	for _, rule := range redaction {
+		pattern, err := jqString(redactionPattern(rule))
+		if err != nil {
+			return extensionsv1alpha1.File{}, err
+		}
+		replacement, err := jqString(ptr.Deref(rule.Replacement, defaultRedactionReplacement))
+		if err != nil {
+			return extensionsv1alpha1.File{}, err
+		}
+		rules = append(rules, map[string]string{
+			"pattern":     pattern,
+			"replacement": replacement,
+		})
+	}
+
+	var script bytes.Buffer
+	if err := redactMessagesScriptTemplate.Execute(&script, map[string]interface{}{
+		"rules": rules,
+	}); err != nil {
+		return extensionsv1alpha1.File{}, err
+	}
+
+	return extensionsv1alpha1.File{
+		Path:        constants.RedactMessagesScriptPath,
+		Permissions: ptr.To(uint32(0744)),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: "b64",
+				Data:     gardenerutils.EncodeBase64(script.Bytes()),
+			},
+		},
+	}, nil
+}
+
+// jqString returns the given value as a jq string literal. JSON strings are valid jq string literals as long as they
+// do not contain the `\(` interpolation sequence, which the JSON encoding never produces.
+func jqString(value string) (string, error) {
+	var literal bytes.Buffer
+	encoder := json.NewEncoder(&literal)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(literal.String(), "\n"), nil
+}
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/constants"
+)
+
+const (
+	// valitailConfigPath is the path of the configuration of the logging agent which Gardener adds to the
+	// OperatingSystemConfig of the nodes if the logging stack of the shoot is enabled.
+	valitailConfigPath = "/var/lib/valitail/config/config"
+	// valiAuthorizationFileName is the name of the file containing the value of the Authorization header sent to Vali.
+	valiAuthorizationFileName = "authorization"
+	// valiCACertFileName is the name of the file containing the certificate authority of the Vali endpoint.
+	valiCACertFileName = "ca.crt"
+	// valiQueueSize is the number of log messages which are held in memory while Vali is not reachable, further log
+	// messages are not pushed to Vali.
+	valiQueueSize = 10000
+)
+
+var valitailFilePathRegex = regexp.MustCompile(`^/[a-zA-Z0-9._/-]+$`)
+
+// valiPushEndpoint is the push API of the Vali instance of the shoot together with the credentials of the logging
+// agent of the nodes, which rsyslog uses for pushing log messages to it.
+type valiPushEndpoint struct {
+	server    string
+	port      int
+	path      string
+	tokenFile string
+	caFile    string
+}
+
+type valitailConfig struct {
+	Client  *valitailClientConfig  `json:"client,omitempty"`
+	Clients []valitailClientConfig `json:"clients,omitempty"`
+}
+
+type valitailClientConfig struct {
+	URL             string `json:"url"`
+	BearerTokenFile string `json:"bearer_token_file"`
+	TLSConfig       struct {
+		CAFile string `json:"ca_file"`
+	} `json:"tls_config"`
+}
+
+// getValiPushEndpoint returns the push API of the Vali instance of the shoot as configured for the logging agent in
+// the given files of the OperatingSystemConfig. It returns an error if the logging agent is not configured or its
+// configuration is not understood.
+func getValiPushEndpoint(files []extensionsv1alpha1.File) (*valiPushEndpoint, error) {
+	var content []byte
+	for _, file := range files {
+		if file.Path != valitailConfigPath {
+			continue
+		}
+		if file.Content.Inline == nil {
+			return nil, fmt.Errorf("the content of %s is not inline", valitailConfigPath)
+		}
+
+		content = []byte(file.Content.Inline.Data)
+		if file.Content.Inline.Encoding == "b64" {
+			var err error
+			if content, err = base64.StdEncoding.DecodeString(file.Content.Inline.Data); err != nil {
+				return nil, fmt.Errorf("failed to decode %s: %w", valitailConfigPath, err)
+			}
+		}
+	}
+	if content == nil {
+		return nil, fmt.Errorf("the logging agent is not configured in %s, the logging stack of the shoot might be disabled", valitailConfigPath)
+	}
+
+	config := &valitailConfig{}
+	if err := yaml.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", valitailConfigPath, err)
+	}
+
+	client := config.Client
+	if client == nil && len(config.Clients) > 0 {
+		client = &config.Clients[0]
+	}
+	if client == nil {
+		return nil, fmt.Errorf("no client is configured in %s", valitailConfigPath)
+	}
+
+	pushURL, err := url.Parse(client.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the url of the client in %s: %w", valitailConfigPath, err)
+	}
+	if pushURL.Scheme != "https" {
+		return nil, fmt.Errorf("the url of the client in %s does not use https", valitailConfigPath)
+	}
+
+	port := 443
+	if pushURL.Port() != "" {
+		if port, err = strconv.Atoi(pushURL.Port()); err != nil {
+			return nil, fmt.Errorf("failed to parse the port of the client in %s: %w", valitailConfigPath, err)
+		}
+	}
+
+	// The paths of the credentials are written to a file sourced by the configure-rsyslog.sh script.
+	for _, file := range []string{client.BearerTokenFile, client.TLSConfig.CAFile} {
+		if !valitailFilePathRegex.MatchString(file) {
+			return nil, fmt.Errorf("the client in %s does not refer to a bearer token and a certificate authority file with a supported path", valitailConfigPath)
+		}
+	}
+
+	return &valiPushEndpoint{
+		server:    pushURL.Hostname(),
+		port:      port,
+		path:      strings.TrimPrefix(pushURL.Path, "/"),
+		tokenFile: client.BearerTokenFile,
+		caFile:    client.TLSConfig.CAFile,
+	}, nil
+}
+
+// getValiValues returns the values of the rsyslog action pushing the log messages to Vali. It reads the credentials
+// from the directory the configure-rsyslog.sh script copies them to from the logging agent.
+func getValiValues(valiPush *valiPushEndpoint) map[string]interface{} {
+	return map[string]interface{}{
+		"server":            valiPush.server,
+		"port":              valiPush.port,
+		"path":              valiPush.path,
+		"caPath":            path.Join(constants.RsyslogValiDir, valiCACertFileName),
+		"authorizationPath": path.Join(constants.RsyslogValiDir, valiAuthorizationFileName),
+		"queueSize":         valiQueueSize,
+	}
+}
+
+// getValiCredentialsFile returns the file telling the configure-rsyslog.sh script where the credentials of the logging
+// agent are stored on the nodes.
+func getValiCredentialsFile(valiPush *valiPushEndpoint) extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        constants.ValiCredentialsFromOSCPath,
+		Permissions: ptr.To(uint32(0600)),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Data: fmt.Sprintf("VALI_TOKEN_FILE=%s\nVALI_CA_FILE=%s\n", valiPush.tokenFile, valiPush.caFile),
+			},
+		},
+	}
+}
@@ -5,12 +5,15 @@
 package operatingsystemconfig
 
 import (
+	"context"
+
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/extensions/pkg/webhook/controlplane/genericmutator"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
 	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -28,14 +31,14 @@ func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 
 	decoder := serializer.NewCodecFactory(mgr.GetScheme()).UniversalDecoder()
 
-	mutator := genericmutator.NewMutator(
+	mutator := &operatingSystemConfigMutator{Mutator: genericmutator.NewMutator(
 		mgr,
 		NewEnsurer(mgr.GetClient(), decoder, logger),
 		oscutils.NewUnitSerializer(),
 		kubelet.NewConfigCodec(fciCodec),
 		fciCodec,
 		logger,
-	)
+	)}
 	types := []extensionswebhook.Type{
 		{Obj: &extensionsv1alpha1.OperatingSystemConfig{}},
 	}
@@ -59,3 +62,28 @@ func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 
 	return webhook, nil
 }
+
+type operatingSystemConfigKey struct{}
+
+// WithOperatingSystemConfig returns a copy of the given context which carries the given OperatingSystemConfig.
+func WithOperatingSystemConfig(ctx context.Context, osc *extensionsv1alpha1.OperatingSystemConfig) context.Context {
+	return context.WithValue(ctx, operatingSystemConfigKey{}, osc)
+}
+
+func operatingSystemConfigFromContext(ctx context.Context) *extensionsv1alpha1.OperatingSystemConfig {
+	osc, _ := ctx.Value(operatingSystemConfigKey{}).(*extensionsv1alpha1.OperatingSystemConfig)
+	return osc
+}
+
+// operatingSystemConfigMutator passes the mutated OperatingSystemConfig to the ensurer via the context, as the
+// generic mutator only passes its files and units.
+type operatingSystemConfigMutator struct {
+	extensionswebhook.Mutator
+}
+
+func (m *operatingSystemConfigMutator) Mutate(ctx context.Context, newObj, oldObj client.Object) error {
+	if osc, ok := newObj.(*extensionsv1alpha1.OperatingSystemConfig); ok {
+		ctx = WithOperatingSystemConfig(ctx, osc)
+	}
+	return m.Mutator.Mutate(ctx, newObj, oldObj)
+}
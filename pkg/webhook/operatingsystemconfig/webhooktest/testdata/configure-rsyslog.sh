@@ -126,19 +126,53 @@ function configure_rsyslog() {
     restart_rsyslog=true
   fi
 
+  # rsyslog only reads the tls files and the tokens stored next to them on startup, hence it is restarted whenever they
+  # change, e.g. when a referenced secret is replaced.
   if [[ -d /var/lib/rsyslog-relp-configurator/tls ]] && [[ -n "$(ls -A "/var/lib/rsyslog-relp-configurator/tls" )" ]]; then
     if [[ ! -d /etc/ssl/rsyslog ]]; then
       mkdir -p /etc/ssl/rsyslog
     fi
     if ! diff -rq /var/lib/rsyslog-relp-configurator/tls /etc/ssl/rsyslog ; then
       rm -rf /etc/ssl/rsyslog/*
-      cp -fL /var/lib/rsyslog-relp-configurator/tls/* /etc/ssl/rsyslog/
+      cp -rfL /var/lib/rsyslog-relp-configurator/tls/* /etc/ssl/rsyslog/
       restart_rsyslog=true
     fi
   elif [[ -d /etc/ssl/rsyslog ]]; then
     rm -rf /etc/ssl/rsyslog
   fi
 
+  # The log messages are pushed to Vali with the credentials of the logging agent of the node. rsyslog only reads them on
+  # startup, hence they are copied and rsyslog is restarted whenever the logging agent rotates them.
+  if [[ -f /var/lib/rsyslog-relp-configurator/vali/credentials ]]; then
+    # shellcheck source=/dev/null
+    source /var/lib/rsyslog-relp-configurator/vali/credentials
+    if [[ ! -d /etc/ssl/rsyslog-vali ]]; then
+      mkdir -p -m 0700 /etc/ssl/rsyslog-vali
+    fi
+    if [[ -f "${VALI_TOKEN_FILE}" ]] && [[ -f "${VALI_CA_FILE}" ]]; then
+      (umask 077 && printf 'Bearer %s' "$(cat "${VALI_TOKEN_FILE}")" > /etc/ssl/rsyslog-vali/authorization.new)
+      cp -fL "${VALI_CA_FILE}" /etc/ssl/rsyslog-vali/ca.crt.new
+      for file in authorization ca.crt; do
+        if cmp -s "/etc/ssl/rsyslog-vali/${file}.new" "/etc/ssl/rsyslog-vali/${file}"; then
+          rm -f "/etc/ssl/rsyslog-vali/${file}.new"
+        else
+          mv -f "/etc/ssl/rsyslog-vali/${file}.new" "/etc/ssl/rsyslog-vali/${file}"
+          restart_rsyslog=true
+        fi
+      done
+    fi
+  elif [[ -d /etc/ssl/rsyslog-vali ]]; then
+    rm -rf /etc/ssl/rsyslog-vali
+  fi
+
+  # The local copy of the forwarded log messages is removed once it is disabled. While it is enabled, the rotated files
+  # which exceeded their maximum age are deleted, as the file might not be rotated for a long time.
+  if [[ -f /var/lib/rsyslog-relp-configurator/rotate-local-copy.sh ]]; then
+    /var/lib/rsyslog-relp-configurator/rotate-local-copy.sh --delete-expired
+  elif [[ -d /var/log/rsyslog-relp ]]; then
+    rm -rf /var/log/rsyslog-relp
+  fi
+
   if ! systemctl is-active --quiet rsyslog.service ; then
     # Ensure that the rsyslog service is running.
     systemctl start rsyslog.service
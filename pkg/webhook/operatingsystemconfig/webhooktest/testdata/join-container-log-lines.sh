@@ -0,0 +1,18 @@
+#!/bin/bash
+
+# SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+#
+# SPDX-License-Identifier: Apache-2.0
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+# This script is executed by rsyslog for the container logs which the container runtime split into several partial
+# lines. It reads one log message per line as a JSON object, whose message text contains the lines of the log file
+# joined by newlines, and replies with the message text of the joined lines without the prefixes of the container
+# runtime as a JSON object.
+exec jq --compact-output --unbuffered "$(cat <<'JQ'
+{msg: (.msg | split("\n") | map(sub("^[^ ]+ (stdout|stderr) [FP] "; "")) | join(""))}
+JQ
+)"
@@ -13,9 +13,11 @@ process_json() {
 
   echo $json | \
     jq -r '
-      ([to_entries[] | select(.value|type=="string") | "\(.key)=\"\(.value)\""] | join(",")) as $labels
+      # Dynamic statistics, e.g. the messages read from the kernel ring buffer, are nested in "values".
+      (if (.values | type) == "object" then del(.values) + .values else . end)
+      | ([to_entries[] | select(.value|type=="string") | "\(.key)=\"\(.value)\""] | join(",")) as $labels
       | to_entries[] | select(.value|type=="number")
-      | "rsyslog_pstat_\(.key | sub("\\.";"_")){\($labels)} \(.value)"
+      | "rsyslog_pstat_\(.key | gsub("\\.";"_")){\($labels)} \(.value)"
     ' || { logger -p error -t  process_rsyslog_pstats.sh  "Error processing JSON: $json"; exit 1; }
 }
 
@@ -41,6 +43,15 @@ add_comments() {
     *"rsyslog_pstat_failed")
       help_and_type=('Number of messages failed' 'counter')
       ;;
+    *"rsyslog_pstat_redaction_candidates")
+      help_and_type=('Number of messages matched by the redaction rules and passed to the redaction script' 'counter')
+      ;;
+    *"rsyslog_pstat_rate_limited")
+      help_and_type=('Number of messages discarded by the rate limit of a logging rule' 'counter')
+      ;;
+    *"rsyslog_pstat_sampled_out")
+      help_and_type=('Number of messages discarded by the sampling of a logging rule' 'counter')
+      ;;
     *"rsyslog_pstat_suspended")
       help_and_type=('Number of times suspended' 'counter')
       ;;
@@ -98,6 +109,15 @@ add_comments() {
     *"rsyslog_pstat_maxqsize")
       help_and_type=('Maximum size queue has reached' 'gauge')
       ;;
+    *"rsyslog_pstat_request_count")
+      help_and_type=('Number of http requests sent' 'counter')
+      ;;
+    *"rsyslog_pstat_request_success")
+      help_and_type=('Number of http requests sent successfully' 'counter')
+      ;;
+    *"rsyslog_pstat_request_fail")
+      help_and_type=('Number of http requests which failed' 'counter')
+      ;;
   esac
 
   if [ ${#help_and_type[@]} -eq 0 ]; then
@@ -0,0 +1,27 @@
+#!/bin/bash
+
+# SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+#
+# SPDX-License-Identifier: Apache-2.0
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+# This script is executed by rsyslog for the log messages matching at least one of the redaction rules. It reads the
+# message text of one log message per line and replies with the redacted message text as a JSON object. If it is
+# executed with --container-logs, the prefix of the container runtime is kept unchanged.
+container_logs=false
+if [[ "${1:-}" == "--container-logs" ]]; then
+  container_logs=true
+fi
+
+exec jq --raw-input --compact-output --unbuffered --argjson container_logs "${container_logs}" "$(cat <<'JQ'
+(if $container_logs then first(capture("^(?<prefix>[^ ]+ (stdout|stderr) [FP] )").prefix) // "" else "" end) as $prefix
+| {msg: ($prefix + (.[($prefix | length):]
+  | gsub("eyJ[A-Za-z0-9_-]+\\.eyJ[A-Za-z0-9_-]+\\.[A-Za-z0-9_-]*"; "[REDACTED]")
+  | gsub("[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\\.[A-Za-z]{2,}"; "<email>")
+  | gsub("password=[^ \"]+"; "password=***")
+))}
+JQ
+)"
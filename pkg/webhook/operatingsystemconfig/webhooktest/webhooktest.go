@@ -18,6 +18,54 @@ var (
 	rsyslogConfig []byte
 	//go:embed testdata/60-audit-with-tls.conf
 	rsyslogConfigWithTLS []byte
+	//go:embed testdata/60-audit-with-additional-targets.conf
+	rsyslogConfigWithAdditionalTargets []byte
+	//go:embed testdata/60-audit-with-failover-target.conf
+	rsyslogConfigWithFailoverTarget []byte
+	//go:embed testdata/60-audit-with-queue.conf
+	rsyslogConfigWithQueue []byte
+	//go:embed testdata/60-audit-with-output-format-rfc5424.conf
+	rsyslogConfigWithOutputFormatRFC5424 []byte
+	//go:embed testdata/60-audit-with-output-format-rfc3164.conf
+	rsyslogConfigWithOutputFormatRFC3164 []byte
+	//go:embed testdata/60-audit-with-output-format-json.conf
+	rsyslogConfigWithOutputFormatJSON []byte
+	//go:embed testdata/60-audit-with-static-metadata.conf
+	rsyslogConfigWithStaticMetadata []byte
+	//go:embed testdata/60-audit-with-node-metadata.conf
+	rsyslogConfigWithNodeMetadata []byte
+	//go:embed testdata/60-audit-with-worker-pool-overrides.conf
+	rsyslogConfigWithWorkerPoolOverrides []byte
+	//go:embed testdata/60-audit-with-imjournal.conf
+	rsyslogConfigWithImjournal []byte
+	//go:embed testdata/60-audit-with-container-logs.conf
+	rsyslogConfigWithContainerLogs []byte
+	//go:embed testdata/60-audit-with-container-logs-and-exclude-rules.conf
+	rsyslogConfigWithContainerLogsAndExcludeRules []byte
+	//go:embed testdata/60-audit-with-kernel-logs.conf
+	rsyslogConfigWithKernelLogs []byte
+	//go:embed testdata/60-audit-with-tcp.conf
+	rsyslogConfigWithTCP []byte
+	//go:embed testdata/60-audit-with-http.conf
+	rsyslogConfigWithHTTP []byte
+	//go:embed testdata/60-audit-with-otlp.conf
+	rsyslogConfigWithOTLP []byte
+	//go:embed testdata/60-audit-with-vali.conf
+	rsyslogConfigWithVali []byte
+	//go:embed testdata/60-audit-with-local-copy.conf
+	rsyslogConfigWithLocalCopy []byte
+	//go:embed testdata/60-audit-with-exclude-rules.conf
+	rsyslogConfigWithExcludeRules []byte
+	//go:embed testdata/60-audit-with-redaction.conf
+	rsyslogConfigWithRedaction []byte
+	//go:embed testdata/60-audit-with-rate-limits.conf
+	rsyslogConfigWithRateLimits []byte
+	//go:embed testdata/60-audit-with-transformations.conf
+	rsyslogConfigWithTransformations []byte
+	//go:embed testdata/60-audit-with-audit-stream.conf
+	rsyslogConfigWithAuditStream []byte
+	//go:embed testdata/60-audit-with-audit-stream-and-exclude-rules.conf
+	rsyslogConfigWithAuditStreamAndExcludeRules []byte
 	//go:embed testdata/rsyslog-config-simple.conf.tpl
 	rsyslogConfigSimple []byte
 
@@ -25,6 +73,12 @@ var (
 	confiugreRsyslogScript []byte
 	//go:embed testdata/process-rsyslog-pstats.sh
 	processRsyslogPstatsScript []byte
+	//go:embed testdata/rotate-local-copy.sh
+	rotateLocalCopyScript []byte
+	//go:embed testdata/redact-messages.sh
+	redactMessagesScript []byte
+	//go:embed testdata/join-container-log-lines.sh
+	joinContainerLogLinesScript []byte
 
 	//go:embed testdata/00-base-config.rules
 	baseConfigRules []byte
@@ -133,33 +187,42 @@ MemorySwapMax=0`, "old"),
 
 // GetRsyslogTLSFiles returns default Rsyslog TLS files
 func GetRsyslogTLSFiles(useExpectedContent bool) []extensionsv1alpha1.File {
+	return getRsyslogTLSFiles("/var/lib/rsyslog-relp-configurator/tls", "ref-rsyslog-tls", useExpectedContent)
+}
+
+// GetAdditionalTargetRsyslogTLSFiles returns the Rsyslog TLS files of the additional or failover target with the given name
+func GetAdditionalTargetRsyslogTLSFiles(targetName, secretName string, useExpectedContent bool) []extensionsv1alpha1.File {
+	return getRsyslogTLSFiles("/var/lib/rsyslog-relp-configurator/tls/"+targetName, "ref-"+secretName, useExpectedContent)
+}
+
+func getRsyslogTLSFiles(dir, secretName string, useExpectedContent bool) []extensionsv1alpha1.File {
 	return []extensionsv1alpha1.File{
 		{
-			Path:        "/var/lib/rsyslog-relp-configurator/tls/ca.crt",
+			Path:        dir + "/ca.crt",
 			Permissions: ptr.To(uint32(0600)),
 			Content: extensionsv1alpha1.FileContent{
 				SecretRef: &extensionsv1alpha1.FileContentSecretRef{
-					Name:    GetBasedOnCondition(useExpectedContent, "ref-rsyslog-tls", "ref-rsyslog-tls-old"),
+					Name:    GetBasedOnCondition(useExpectedContent, secretName, secretName+"-old"),
 					DataKey: "ca",
 				},
 			},
 		},
 		{
-			Path:        "/var/lib/rsyslog-relp-configurator/tls/tls.crt",
+			Path:        dir + "/tls.crt",
 			Permissions: ptr.To(uint32(0600)),
 			Content: extensionsv1alpha1.FileContent{
 				SecretRef: &extensionsv1alpha1.FileContentSecretRef{
-					Name:    GetBasedOnCondition(useExpectedContent, "ref-rsyslog-tls", "ref-rsyslog-tls-old"),
+					Name:    GetBasedOnCondition(useExpectedContent, secretName, secretName+"-old"),
 					DataKey: "crt",
 				},
 			},
 		},
 		{
-			Path:        "/var/lib/rsyslog-relp-configurator/tls/tls.key",
+			Path:        dir + "/tls.key",
 			Permissions: ptr.To(uint32(0600)),
 			Content: extensionsv1alpha1.FileContent{
 				SecretRef: &extensionsv1alpha1.FileContentSecretRef{
-					Name:    GetBasedOnCondition(useExpectedContent, "ref-rsyslog-tls", "ref-rsyslog-tls-old"),
+					Name:    GetBasedOnCondition(useExpectedContent, secretName, secretName+"-old"),
 					DataKey: "key",
 				},
 			},
@@ -167,6 +230,119 @@ func GetRsyslogTLSFiles(useExpectedContent bool) []extensionsv1alpha1.File {
 	}
 }
 
+// GetRsyslogHTTPTokenFile returns the file containing the token for the http ingestion endpoint
+func GetRsyslogHTTPTokenFile(useExpectedContent bool) extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        "/var/lib/rsyslog-relp-configurator/tls/token",
+		Permissions: ptr.To(uint32(0600)),
+		Content: extensionsv1alpha1.FileContent{
+			SecretRef: &extensionsv1alpha1.FileContentSecretRef{
+				Name:    GetBasedOnCondition(useExpectedContent, "ref-rsyslog-token", "ref-rsyslog-token-old"),
+				DataKey: "token",
+			},
+		},
+	}
+}
+
+// GetPodMetadataReaderTokenFile returns the file containing the token with which the metadata of the pods is read
+func GetPodMetadataReaderTokenFile() extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        "/var/lib/rsyslog-relp-configurator/tls/kubernetes/token",
+		Permissions: ptr.To(uint32(0600)),
+		Content: extensionsv1alpha1.FileContent{
+			SecretRef: &extensionsv1alpha1.FileContentSecretRef{
+				Name:    "shoot-access-rsyslog-relp-pod-metadata-reader",
+				DataKey: "token",
+			},
+		},
+	}
+}
+
+// GetValitailConfigFile returns the configuration of the logging agent which Gardener adds to the nodes if the logging
+// stack of the shoot is enabled
+func GetValitailConfigFile() extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        "/var/lib/valitail/config/config",
+		Permissions: ptr.To(uint32(0644)),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: "b64",
+				Data: base64.StdEncoding.EncodeToString([]byte(`server:
+  disable: true
+  log_level: info
+  http_listen_port: 3001
+client:
+  url: https://v-foo.ingress.example.com/vali/api/v1/push
+  batchwait: 10s
+  batchsize: 1536000
+  bearer_token_file: /var/lib/valitail/auth-token
+  tls_config:
+    ca_file: /var/lib/valitail/ca.crt
+    server_name: v-foo.ingress.example.com
+positions:
+  filename: /var/log/positions.yaml
+`)),
+			},
+		},
+	}
+}
+
+// GetValiCredentialsFile returns the file referring to the credentials of the logging agent with which the log
+// messages are pushed to Vali
+func GetValiCredentialsFile() extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        "/var/lib/rsyslog-relp-configurator/vali/credentials",
+		Permissions: ptr.To(uint32(0600)),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Data: "VALI_TOKEN_FILE=/var/lib/valitail/auth-token\nVALI_CA_FILE=/var/lib/valitail/ca.crt\n",
+			},
+		},
+	}
+}
+
+// GetRotateLocalCopyScriptFile returns the script rotating the local copy of the forwarded log messages
+func GetRotateLocalCopyScriptFile(useExpectedContent bool) extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        "/var/lib/rsyslog-relp-configurator/rotate-local-copy.sh",
+		Permissions: ptr.To(uint32(0744)),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: "b64",
+				Data:     base64.StdEncoding.EncodeToString(GetBasedOnCondition(useExpectedContent, rotateLocalCopyScript, []byte("oldContent"))),
+			},
+		},
+	}
+}
+
+// GetRedactMessagesScriptFile returns the script redacting the log messages before they are forwarded
+func GetRedactMessagesScriptFile(useExpectedContent bool) extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        "/var/lib/rsyslog-relp-configurator/redact-messages.sh",
+		Permissions: ptr.To(uint32(0744)),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: "b64",
+				Data:     base64.StdEncoding.EncodeToString(GetBasedOnCondition(useExpectedContent, redactMessagesScript, []byte("oldContent"))),
+			},
+		},
+	}
+}
+
+// GetJoinContainerLogLinesScriptFile returns the script joining the partial lines of the container logs
+func GetJoinContainerLogLinesScriptFile(useExpectedContent bool) extensionsv1alpha1.File {
+	return extensionsv1alpha1.File{
+		Path:        "/var/lib/rsyslog-relp-configurator/join-container-log-lines.sh",
+		Permissions: ptr.To(uint32(0744)),
+		Content: extensionsv1alpha1.FileContent{
+			Inline: &extensionsv1alpha1.FileContentInline{
+				Encoding: "b64",
+				Data:     base64.StdEncoding.EncodeToString(GetBasedOnCondition(useExpectedContent, joinContainerLogLinesScript, []byte("oldContent"))),
+			},
+		},
+	}
+}
+
 // GetRsyslogConfiguratorUnit returns the Rsyslog configuration unit
 func GetRsyslogConfiguratorUnit(useExpectedContent bool) extensionsv1alpha1.Unit {
 	return extensionsv1alpha1.Unit{
@@ -204,6 +380,126 @@ func GetRsyslogConfigWithTLS() []byte {
 	return rsyslogConfigWithTLS
 }
 
+// GetRsyslogConfigWithAdditionalTargets returns an rsyslog config with additional relp targets
+func GetRsyslogConfigWithAdditionalTargets() []byte {
+	return rsyslogConfigWithAdditionalTargets
+}
+
+// GetRsyslogConfigWithFailoverTarget returns an rsyslog config with a failover relp target
+func GetRsyslogConfigWithFailoverTarget() []byte {
+	return rsyslogConfigWithFailoverTarget
+}
+
+// GetRsyslogConfigWithQueue returns an rsyslog config with custom queue settings
+func GetRsyslogConfigWithQueue() []byte {
+	return rsyslogConfigWithQueue
+}
+
+// GetRsyslogConfigWithOutputFormat returns an rsyslog config which sends log messages in the given output format
+func GetRsyslogConfigWithOutputFormat(outputFormat string) []byte {
+	switch outputFormat {
+	case "rfc5424":
+		return rsyslogConfigWithOutputFormatRFC5424
+	case "rfc3164":
+		return rsyslogConfigWithOutputFormatRFC3164
+	case "json":
+		return rsyslogConfigWithOutputFormatJSON
+	}
+	return nil
+}
+
+// GetRsyslogConfigWithStaticMetadata returns an rsyslog config which adds static metadata to the log messages
+func GetRsyslogConfigWithStaticMetadata() []byte {
+	return rsyslogConfigWithStaticMetadata
+}
+
+// GetRsyslogConfigWithNodeMetadata returns an rsyslog config which adds node metadata to the log messages
+func GetRsyslogConfigWithNodeMetadata() []byte {
+	return rsyslogConfigWithNodeMetadata
+}
+
+// GetRsyslogConfigWithWorkerPoolOverrides returns an rsyslog config with the overrides of a worker pool applied
+func GetRsyslogConfigWithWorkerPoolOverrides() []byte {
+	return rsyslogConfigWithWorkerPoolOverrides
+}
+
+// GetRsyslogConfigWithImjournal returns an rsyslog config which reads log messages from the journal
+func GetRsyslogConfigWithImjournal() []byte {
+	return rsyslogConfigWithImjournal
+}
+
+// GetRsyslogConfigWithContainerLogs returns an rsyslog config which forwards the logs of selected containers
+func GetRsyslogConfigWithContainerLogs() []byte {
+	return rsyslogConfigWithContainerLogs
+}
+
+// GetRsyslogConfigWithContainerLogsAndExcludeRules returns an rsyslog config which applies the exclude rules to the
+// forwarded container logs
+func GetRsyslogConfigWithContainerLogsAndExcludeRules() []byte {
+	return rsyslogConfigWithContainerLogsAndExcludeRules
+}
+
+// GetRsyslogConfigWithKernelLogs returns an rsyslog config which forwards the messages of the kernel ring buffer
+func GetRsyslogConfigWithKernelLogs() []byte {
+	return rsyslogConfigWithKernelLogs
+}
+
+// GetRsyslogConfigWithTCP returns an rsyslog config which sends log messages via tcp secured with tls
+func GetRsyslogConfigWithTCP() []byte {
+	return rsyslogConfigWithTCP
+}
+
+// GetRsyslogConfigWithHTTP returns an rsyslog config which sends log messages in batches to an http ingestion endpoint
+func GetRsyslogConfigWithHTTP() []byte {
+	return rsyslogConfigWithHTTP
+}
+
+// GetRsyslogConfigWithOTLP returns an rsyslog config which exports log messages as OpenTelemetry log records via OTLP/HTTP
+func GetRsyslogConfigWithOTLP() []byte {
+	return rsyslogConfigWithOTLP
+}
+
+// GetRsyslogConfigWithVali returns an rsyslog config which also pushes the forwarded log messages to the Vali of the shoot
+func GetRsyslogConfigWithVali() []byte {
+	return rsyslogConfigWithVali
+}
+
+// GetRsyslogConfigWithLocalCopy returns an rsyslog config which also keeps a local copy of the forwarded log messages
+func GetRsyslogConfigWithLocalCopy() []byte {
+	return rsyslogConfigWithLocalCopy
+}
+
+// GetRsyslogConfigWithExcludeRules returns an rsyslog config which discards log messages matching the exclude rules
+func GetRsyslogConfigWithExcludeRules() []byte {
+	return rsyslogConfigWithExcludeRules
+}
+
+// GetRsyslogConfigWithRedaction returns an rsyslog config which redacts log messages before they are forwarded
+func GetRsyslogConfigWithRedaction() []byte {
+	return rsyslogConfigWithRedaction
+}
+
+// GetRsyslogConfigWithRateLimits returns an rsyslog config with rate limited and sampled logging rules
+func GetRsyslogConfigWithRateLimits() []byte {
+	return rsyslogConfigWithRateLimits
+}
+
+// GetRsyslogConfigWithTransformations returns an rsyslog config with logging rules transforming the log messages
+func GetRsyslogConfigWithTransformations() []byte {
+	return rsyslogConfigWithTransformations
+}
+
+// GetRsyslogConfigWithAuditStream returns an rsyslog config which sends audit events via a dedicated audit stream
+func GetRsyslogConfigWithAuditStream() []byte {
+	return rsyslogConfigWithAuditStream
+}
+
+// GetRsyslogConfigWithAuditStreamAndExcludeRules returns an rsyslog config which sends audit events via a dedicated
+// audit stream before the exclude rules are applied
+func GetRsyslogConfigWithAuditStreamAndExcludeRules() []byte {
+	return rsyslogConfigWithAuditStreamAndExcludeRules
+}
+
 // GetTestingRsyslogConfig returns a custom rsyslog config for testing optional additions
 func GetTestingRsyslogConfig() []byte {
 	return rsyslogConfig
@@ -13,6 +13,7 @@ import (
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	rsyslogv1alpha1 "github.com/gardener/gardener-extension-shoot-rsyslog-relp/pkg/apis/rsyslog/v1alpha1"
@@ -30,7 +31,7 @@ func AddOrUpdateRsyslogRelpExtension(shoot *gardencorev1beta1.Shoot, opts ...fun
 		LoggingRules: []rsyslogv1alpha1.LoggingRule{
 			{
 				ProgramNames: []string{"test-program"},
-				Severity:     ptr.To(1),
+				Severity:     ptr.To(intstr.FromInt32(1)),
 			},
 		},
 	}
@@ -15,6 +15,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -145,7 +146,7 @@ var _ = Describe("Shoot Rsyslog Relp Extension Tests", func() {
 		enableExtensionFunc := func(shoot *gardencorev1beta1.Shoot) error {
 			loggingRule := v1alpha1.LoggingRule{
 				ProgramNames: []string{"filter-program"},
-				Severity:     ptr.To(3),
+				Severity:     ptr.To(intstr.FromInt32(3)),
 				MessageContent: &v1alpha1.MessageContent{
 					Regex:   ptr.To("included"),
 					Exclude: ptr.To("excluded"),
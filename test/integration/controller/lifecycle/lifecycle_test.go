@@ -83,6 +83,10 @@ if [[ -d /host/var/log/rsyslog ]]; then
   rm -rf /host/var/log/rsyslog
 fi
 
+if [[ -d /host/var/log/rsyslog-relp ]]; then
+  rm -rf /host/var/log/rsyslog-relp
+fi
+
 if [[ -f /host/etc/audit/plugins.d/syslog.conf ]]; then
   sed -i "s/^active\\>.*/active = no/i" /host/etc/audit/plugins.d/syslog.conf
 fi